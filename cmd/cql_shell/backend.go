@@ -0,0 +1,165 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/deepfabric/indexer"
+	"github.com/deepfabric/indexer/coordinator"
+	"github.com/deepfabric/indexer/cql"
+	"github.com/pkg/errors"
+)
+
+//backend executes statements parsed by the shell, either on an Indexer opened by the shell or on an indexer_server.
+type backend interface {
+	//docProts returns the schemas of all indices.
+	docProts() (docProts map[string]*cql.DocumentWithIdx, err error)
+	//exec executes stmt, which is the result of cql.ParseCql(text). qr is the result of a select, found is the result of a delete.
+	exec(text string, stmt interface{}) (qr *indexer.QueryResult, found bool, err error)
+	summary() (sum string, err error)
+	close() error
+}
+
+//localBackend executes statements on an Indexer in the same process.
+type localBackend struct {
+	ir *indexer.Indexer
+}
+
+func (b *localBackend) docProts() (docProts map[string]*cql.DocumentWithIdx, err error) {
+	docProts = make(map[string]*cql.DocumentWithIdx)
+	for _, name := range b.ir.IndexNames() {
+		if docProt := b.ir.GetDocProt(name); docProt != nil {
+			docProts[name] = docProt
+		}
+	}
+	return
+}
+
+func (b *localBackend) exec(text string, stmt interface{}) (qr *indexer.QueryResult, found bool, err error) {
+	switch q := stmt.(type) {
+	case *cql.CqlCreate:
+		err = b.ir.CreateIndex(&q.DocumentWithIdx)
+	case *cql.CqlDestroy:
+		err = b.ir.DestroyIndex(q.Index)
+	case *cql.CqlAlter:
+		err = b.ir.AlterIndex(q)
+	case *cql.CqlInsert:
+		err = b.ir.Insert(&q.DocumentWithIdx)
+	case *cql.CqlDel:
+		found, err = b.ir.Del(q.Index, q.Doc.DocID)
+	case *cql.CqlSelect:
+		qr, err = b.ir.Select(q)
+	default:
+		err = errors.Errorf("unsupported statement %T", stmt)
+	}
+	return
+}
+
+func (b *localBackend) summary() (sum string, err error) {
+	return b.ir.Summary()
+}
+
+func (b *localBackend) close() error {
+	return b.ir.Close()
+}
+
+//httpBackend executes statements on an indexer_server.
+type httpBackend struct {
+	addr   string
+	client *http.Client
+}
+
+//cqlResponse is the union of the JSON responses of POST /cql.
+type cqlResponse struct {
+	coordinator.SelectResponse
+	Found *bool `json:"found,omitempty"`
+}
+
+func newHTTPBackend(addr string) *httpBackend {
+	if !strings.Contains(addr, "://") {
+		addr = "http://" + addr
+	}
+	return &httpBackend{addr: strings.TrimRight(addr, "/"), client: &http.Client{}}
+}
+
+func (b *httpBackend) docProts() (docProts map[string]*cql.DocumentWithIdx, err error) {
+	var list []*cql.DocumentWithIdx
+	if err = b.do(http.MethodGet, "/indices", nil, &list); err != nil {
+		return
+	}
+	docProts = make(map[string]*cql.DocumentWithIdx, len(list))
+	for _, docProt := range list {
+		docProts[docProt.Index] = docProt
+	}
+	return
+}
+
+func (b *httpBackend) exec(text string, stmt interface{}) (qr *indexer.QueryResult, found bool, err error) {
+	var resp cqlResponse
+	if err = b.do(http.MethodPost, "/cql", []byte(text), &resp); err != nil {
+		return
+	}
+	if q, ok := stmt.(*cql.CqlSelect); ok {
+		qr = resp.QueryResult(q.Limit)
+	}
+	if resp.Found != nil {
+		found = *resp.Found
+	}
+	return
+}
+
+func (b *httpBackend) summary() (sum string, err error) {
+	var resp *http.Response
+	if resp, err = b.client.Get(b.addr + "/summary"); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	defer resp.Body.Close()
+	var body []byte
+	if body, err = ioutil.ReadAll(resp.Body); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	if resp.StatusCode != http.StatusOK {
+		err = errors.Errorf("%s: %s", resp.Status, body)
+		return
+	}
+	sum = string(body)
+	return
+}
+
+func (b *httpBackend) close() error {
+	return nil
+}
+
+//do sends a request, and decodes the JSON response into v. An error response is returned as err.
+func (b *httpBackend) do(method, path string, body []byte, v interface{}) (err error) {
+	var req *http.Request
+	if req, err = http.NewRequest(method, b.addr+path, bytes.NewReader(body)); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	var resp *http.Response
+	if resp, err = b.client.Do(req); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		var res struct {
+			Error string `json:"error"`
+		}
+		if json.NewDecoder(resp.Body).Decode(&res) != nil || res.Error == "" {
+			res.Error = resp.Status
+		}
+		err = errors.New(res.Error)
+		return
+	}
+	if err = json.NewDecoder(resp.Body).Decode(v); err != nil {
+		err = errors.Wrap(err, "")
+	}
+	return
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/deepfabric/indexer"
+)
+
+var (
+	dir       = flag.String("dir", "/tmp/indexer", "main directory of the indexer to open, ignored if -server is given")
+	enableWal = flag.Bool("wal", true, "write all operations to WAL, and replay it at start")
+	server    = flag.String("server", "", "address of an indexer_server to connect to instead of opening -dir")
+	history   = flag.String("history", defaultHistory(), "history file, empty to disable")
+	command   = flag.String("c", "", "execute the given statements separated by newlines, and exit")
+)
+
+func defaultHistory() string {
+	home := os.Getenv("HOME")
+	if home == "" {
+		return ""
+	}
+	return filepath.Join(home, ".cql_history")
+}
+
+func main() {
+	flag.Parse()
+
+	var b backend
+	if *server != "" {
+		b = newHTTPBackend(*server)
+	} else {
+		ir, err := indexer.NewIndexerExt(*dir, indexer.IndexerOptions{EnableWal: *enableWal})
+		if err != nil {
+			log.Fatalf("%+v", err)
+		}
+		b = &localBackend{ir: ir}
+	}
+
+	sh, err := newShell(b, os.Stdout, *history)
+	if err != nil {
+		log.Fatalf("%+v", err)
+	}
+	if *command != "" {
+		sh.run(strings.NewReader(*command), false)
+	} else {
+		fi, err := os.Stdin.Stat()
+		interactive := err == nil && fi.Mode()&os.ModeCharDevice != 0
+		if interactive {
+			fmt.Println(`Type \? for help, \q to quit.`)
+		}
+		sh.run(os.Stdin, interactive)
+	}
+	sh.close()
+
+	if err = b.close(); err != nil {
+		log.Fatalf("%+v", err)
+	}
+}
@@ -0,0 +1,424 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/deepfabric/bkdtree"
+	"github.com/deepfabric/indexer"
+	"github.com/deepfabric/indexer/cql"
+	"github.com/pkg/errors"
+)
+
+const helpText = `CQL statements:
+  IDX.CREATE <index> [STORE] SCHEMA <property> <type> [DEFAULT <value>] ...
+  IDX.DESTROY <index>
+  IDX.ALTER <index> ADD <property> <type> | DROP <property> ...
+  IDX.INSERT <index> <docID> <value> ... | <property>=<value> ...
+  IDX.DEL <index> <docID> <value> ...
+  IDX.SELECT <index> WHERE <predicate> ... [ORDERBY <property> [LIMIT <n>]] [HIGHLIGHT]
+  EXPLAIN <statement>     show how a statement is parsed and evaluated, without executing it
+Shell commands:
+  \l                      list indices and their numbers of documents
+  \d [index]              describe an index, or list indices
+  \s                      show history
+  !!                      execute the last statement again
+  !<n>                    execute the statement <n> of history again
+  \?                      show this help
+  \q                      quit
+`
+
+//shell reads statements line by line, executes them on a backend, and prints the results.
+type shell struct {
+	b           backend
+	out         io.Writer
+	docProts    map[string]*cql.DocumentWithIdx
+	history     []string
+	historyFile *os.File
+}
+
+func newShell(b backend, out io.Writer, historyPath string) (sh *shell, err error) {
+	sh = &shell{b: b, out: out}
+	if historyPath == "" {
+		return
+	}
+	var data []byte
+	if data, err = ioutil.ReadFile(historyPath); err != nil && !os.IsNotExist(err) {
+		err = errors.Wrap(err, "")
+		return
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if line != "" {
+			sh.history = append(sh.history, line)
+		}
+	}
+	if sh.historyFile, err = os.OpenFile(historyPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600); err != nil {
+		err = errors.Wrap(err, "")
+	}
+	return
+}
+
+func (sh *shell) close() {
+	if sh.historyFile != nil {
+		sh.historyFile.Close()
+	}
+}
+
+//run executes the lines of in until EOF or \q. The prompt is printed if interactive.
+func (sh *shell) run(in io.Reader, interactive bool) {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for {
+		if interactive {
+			fmt.Fprint(sh.out, "cql> ")
+		}
+		if !scanner.Scan() {
+			if err := scanner.Err(); err != nil {
+				fmt.Fprintf(sh.out, "ERROR: %v\n", err)
+			} else if interactive {
+				fmt.Fprintln(sh.out)
+			}
+			return
+		}
+		if quit := sh.handleLine(scanner.Text()); quit {
+			return
+		}
+	}
+}
+
+//handleLine executes a line. quit is true at \q.
+func (sh *shell) handleLine(line string) (quit bool) {
+	line = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(line), ";"))
+	if line == "" || strings.HasPrefix(line, "--") {
+		return
+	}
+	if strings.HasPrefix(line, "!") {
+		var ok bool
+		if line, ok = sh.recall(line); !ok {
+			return
+		}
+		fmt.Fprintln(sh.out, line)
+	}
+	if strings.HasPrefix(line, "\\") {
+		return sh.meta(line)
+	}
+	sh.addHistory(line)
+	if err := sh.execute(line); err != nil {
+		fmt.Fprintf(sh.out, "ERROR: %v\n", err)
+	}
+	return
+}
+
+//recall returns the statement of history referred by !! or !<n>.
+func (sh *shell) recall(line string) (stmt string, ok bool) {
+	if len(sh.history) == 0 {
+		fmt.Fprintln(sh.out, "ERROR: history is empty")
+		return
+	}
+	if line == "!!" {
+		return sh.history[len(sh.history)-1], true
+	}
+	n, err := strconv.Atoi(line[1:])
+	if err != nil || n < 1 || n > len(sh.history) {
+		fmt.Fprintf(sh.out, "ERROR: no such history entry %v\n", line)
+		return
+	}
+	return sh.history[n-1], true
+}
+
+func (sh *shell) addHistory(line string) {
+	sh.history = append(sh.history, line)
+	if sh.historyFile != nil {
+		fmt.Fprintln(sh.historyFile, line)
+	}
+}
+
+//meta executes a shell command.
+func (sh *shell) meta(line string) (quit bool) {
+	fields := strings.Fields(line)
+	var err error
+	switch fields[0] {
+	case "\\q":
+		quit = true
+	case "\\?", "\\h":
+		fmt.Fprint(sh.out, helpText)
+	case "\\s":
+		for i, stmt := range sh.history {
+			fmt.Fprintf(sh.out, "%5d  %s\n", i+1, stmt)
+		}
+	case "\\l":
+		var sum string
+		if sum, err = sh.b.summary(); err == nil {
+			fmt.Fprint(sh.out, sum)
+		}
+	case "\\d":
+		if err = sh.refresh(); err != nil {
+			break
+		}
+		if len(fields) == 1 {
+			sh.listIndices()
+		} else {
+			err = sh.describe(fields[1])
+		}
+	default:
+		err = errors.Errorf("unknown command %v, try \\?", fields[0])
+	}
+	if err != nil {
+		fmt.Fprintf(sh.out, "ERROR: %v\n", err)
+	}
+	return
+}
+
+//refresh fetches the schemas of all indices, which may have been changed by this shell or others.
+func (sh *shell) refresh() (err error) {
+	sh.docProts, err = sh.b.docProts()
+	return
+}
+
+//parse parses a CQL statement with the latest schemas.
+func (sh *shell) parse(text string) (stmt interface{}, err error) {
+	if err = sh.refresh(); err != nil {
+		return
+	}
+	docs := make(map[string]*cql.Document, len(sh.docProts))
+	for name, docProt := range sh.docProts {
+		docs[name] = &docProt.Doc
+	}
+	stmt, err = cql.ParseCql(text, docs)
+	return
+}
+
+func (sh *shell) execute(line string) (err error) {
+	if fields := strings.Fields(line); strings.ToUpper(fields[0]) == "EXPLAIN" {
+		var stmt interface{}
+		text := strings.TrimSpace(line[len(fields[0]):])
+		if stmt, err = sh.parse(text); err != nil {
+			return
+		}
+		sh.explain(stmt)
+		return
+	}
+	var stmt interface{}
+	if stmt, err = sh.parse(line); err != nil {
+		return
+	}
+	start := time.Now()
+	qr, found, err := sh.b.exec(line, stmt)
+	elapsed := time.Since(start)
+	if err != nil {
+		return
+	}
+	switch q := stmt.(type) {
+	case *cql.CqlSelect:
+		sh.printQueryResult(q, qr, elapsed)
+	case *cql.CqlDel:
+		if found {
+			fmt.Fprintf(sh.out, "DELETED 1 (%v)\n", elapsed)
+		} else {
+			fmt.Fprintf(sh.out, "DELETED 0 (%v)\n", elapsed)
+		}
+	default:
+		fmt.Fprintf(sh.out, "OK (%v)\n", elapsed)
+	}
+	return
+}
+
+//printQueryResult prints docIDs, along with the decoded values of the ORDERBY property if given.
+func (sh *shell) printQueryResult(q *cql.CqlSelect, qr *indexer.QueryResult, elapsed time.Duration) {
+	tw := tabwriter.NewWriter(sh.out, 0, 8, 1, ' ', tabwriter.Debug)
+	if q.OrderBy == "" {
+		docIDs := qr.Bm.Bits()
+		fmt.Fprintln(tw, " docID\t")
+		for i, docID := range docIDs {
+			if q.Limit > 0 && i >= q.Limit {
+				break
+			}
+			fmt.Fprintf(tw, " %d\t\n", docID)
+		}
+		tw.Flush()
+		sh.printHighlights(qr)
+		if q.Limit > 0 && len(docIDs) > q.Limit {
+			fmt.Fprintf(sh.out, "(%d rows, showing the first %d, %v)\n", len(docIDs), q.Limit, elapsed)
+		} else {
+			fmt.Fprintf(sh.out, "(%d rows, %v)\n", len(docIDs), elapsed)
+		}
+		return
+	}
+	uintProp := sh.uintProp(q.Index, q.OrderBy)
+	items := qr.Oa.Finalize()
+	fmt.Fprintf(tw, " docID\t %s\t\n", q.OrderBy)
+	for _, item := range items {
+		point := item.(bkdtree.Point)
+		var val string
+		if len(point.Vals) != 0 {
+			if uintProp != nil {
+				val = cql.FormatUintProp(uintProp, point.Vals[0])
+			} else {
+				val = strconv.FormatUint(point.Vals[0], 10)
+			}
+		}
+		fmt.Fprintf(tw, " %d\t %s\t\n", point.UserData, val)
+	}
+	tw.Flush()
+	sh.printHighlights(qr)
+	fmt.Fprintf(sh.out, "(%d rows, %v)\n", len(items), elapsed)
+}
+
+//printHighlights prints the highlighted fragments of a select with HIGHLIGHT, by docID and property.
+func (sh *shell) printHighlights(qr *indexer.QueryResult) {
+	docIDs := make([]uint64, 0, len(qr.Hl))
+	for docID := range qr.Hl {
+		docIDs = append(docIDs, docID)
+	}
+	sort.Slice(docIDs, func(i, j int) bool { return docIDs[i] < docIDs[j] })
+	for _, docID := range docIDs {
+		props := qr.Hl[docID]
+		for _, name := range sortedKeys(props) {
+			for _, fragment := range props[name] {
+				fmt.Fprintf(sh.out, " %d %s: %s\n", docID, name, fragment)
+			}
+		}
+	}
+}
+
+//uintProp returns the schema of the given UINT or FLOAT property, or nil if not found.
+func (sh *shell) uintProp(index, name string) *cql.UintProp {
+	if docProt, ok := sh.docProts[index]; ok {
+		for _, uintProp := range docProt.Doc.UintProps {
+			if uintProp.Name == name {
+				return uintProp
+			}
+		}
+	}
+	return nil
+}
+
+func (sh *shell) listIndices() {
+	if len(sh.docProts) == 0 {
+		fmt.Fprintln(sh.out, "no index")
+		return
+	}
+	names := make([]string, 0, len(sh.docProts))
+	for name := range sh.docProts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintln(sh.out, name)
+	}
+}
+
+//describe prints the schema of an index.
+func (sh *shell) describe(name string) (err error) {
+	docProt, ok := sh.docProts[name]
+	if !ok {
+		err = errors.Wrap(indexer.ErrIdxNotExist, name)
+		return
+	}
+	fmt.Fprintf(sh.out, "Index %q (version %d, store documents: %v)\n", name, docProt.Version, docProt.StoreDoc)
+	tw := tabwriter.NewWriter(sh.out, 0, 8, 1, ' ', tabwriter.Debug)
+	fmt.Fprintln(tw, " property\t type\t default\t")
+	defaults := make(map[string]string)
+	for _, uintProp := range docProt.Defaults.UintProps {
+		defaults[uintProp.Name] = cql.FormatUintProp(uintProp, uintProp.Val)
+	}
+	for _, enumProp := range docProt.Defaults.EnumProps {
+		defaults[enumProp.Name] = strconv.FormatUint(enumProp.Val, 10)
+	}
+	for _, strProp := range docProt.Defaults.StrProps {
+		defaults[strProp.Name] = strconv.Quote(strProp.Val)
+	}
+	for _, uintProp := range docProt.Doc.UintProps {
+		fmt.Fprintf(tw, " %s\t %s\t %s\t\n", uintProp.Name, uintType(uintProp), defaults[uintProp.Name])
+	}
+	for _, enumProp := range docProt.Doc.EnumProps {
+		fmt.Fprintf(tw, " %s\t ENUM\t %s\t\n", enumProp.Name, defaults[enumProp.Name])
+	}
+	for _, strProp := range docProt.Doc.StrProps {
+		fmt.Fprintf(tw, " %s\t STRING\t %s\t\n", strProp.Name, defaults[strProp.Name])
+	}
+	tw.Flush()
+	return
+}
+
+//uintType returns the CQL type name of a UINT or FLOAT property.
+func uintType(uintProp *cql.UintProp) string {
+	if uintProp.IsFloat {
+		return fmt.Sprintf("FLOAT%d", uintProp.ValLen*8)
+	}
+	return fmt.Sprintf("UINT%d", uintProp.ValLen*8)
+}
+
+//explain prints a parsed statement. A select is printed in the order Index.Select evaluates it.
+func (sh *shell) explain(stmt interface{}) {
+	q, ok := stmt.(*cql.CqlSelect)
+	if !ok {
+		fmt.Fprintf(sh.out, "%T %+v\n", stmt, stmt)
+		return
+	}
+	fmt.Fprintf(sh.out, "Select on index %s, slice by slice:\n", q.Index)
+	step := 1
+	fmt.Fprintf(sh.out, "  %d. live documents\n", step)
+	for _, name := range sortedKeys(q.StrPreds) {
+		step++
+		fmt.Fprintf(sh.out, "  %d. intersect %s CONTAINS %q\n", step, name, q.StrPreds[name].ContWord)
+	}
+	for _, name := range sortedKeys(q.ExistPreds) {
+		step++
+		if q.ExistPreds[name].Exists {
+			fmt.Fprintf(sh.out, "  %d. intersect %s EXISTS\n", step, name)
+		} else {
+			fmt.Fprintf(sh.out, "  %d. subtract %s EXISTS (IS NULL)\n", step, name)
+		}
+	}
+	for _, name := range sortedKeys(q.UintPreds) {
+		step++
+		pred := q.UintPreds[name]
+		format := func(val uint64) string { return strconv.FormatUint(val, 10) }
+		if uintProp := sh.uintProp(q.Index, name); uintProp != nil {
+			format = func(val uint64) string { return cql.FormatUintProp(uintProp, val) }
+		}
+		//the parser leaves an unbounded side at 0 or the max uint64
+		switch {
+		case pred.Low != 0 && pred.High != ^uint64(0):
+			fmt.Fprintf(sh.out, "  %d. intersect %s BETWEEN %s AND %s\n", step, name, format(pred.Low), format(pred.High))
+		case pred.Low != 0:
+			fmt.Fprintf(sh.out, "  %d. intersect %s >= %s\n", step, name, format(pred.Low))
+		case pred.High != ^uint64(0):
+			fmt.Fprintf(sh.out, "  %d. intersect %s <= %s\n", step, name, format(pred.High))
+		default:
+			fmt.Fprintf(sh.out, "  %d. intersect %s of any value\n", step, name)
+		}
+	}
+	if q.OrderBy != "" {
+		step++
+		fmt.Fprintf(sh.out, "  %d. keep the top %d ORDERBY %s\n", step, q.Limit, q.OrderBy)
+	}
+	for _, name := range sortedKeys(q.EnumPreds) {
+		fmt.Fprintf(sh.out, "  ignored: %s IN %v, enum properties are not indexed for predicates\n", name, q.EnumPreds[name].InVals)
+	}
+	if q.OrderBy == "" {
+		fmt.Fprintln(sh.out, "  all matching documents are returned")
+	}
+	if q.Highlight {
+		fmt.Fprintln(sh.out, "  then the terms of STRING predicates are highlighted in the stored documents")
+	}
+}
+
+//sortedKeys returns the keys of a map keyed by property names in order, so that EXPLAIN output is stable.
+func sortedKeys(m interface{}) (keys []string) {
+	for _, key := range reflect.ValueOf(m).MapKeys() {
+		keys = append(keys, key.String())
+	}
+	sort.Strings(keys)
+	return
+}
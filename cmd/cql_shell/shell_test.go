@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/deepfabric/indexer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShell(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "cql_shell_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	ir, err := indexer.NewIndexer(dir, true, false)
+	require.NoError(t, err)
+	var out bytes.Buffer
+	sh, err := newShell(&localBackend{ir: ir}, &out, "")
+	require.NoError(t, err)
+	defer sh.b.close()
+	run := func(line string) string {
+		out.Reset()
+		quit := sh.handleLine(line)
+		require.Equal(t, false, quit)
+		return out.String()
+	}
+
+	//TESTCASE: statements
+	require.Equal(t, "no index\n", run(`\d`))
+	require.Contains(t, run(`IDX.CREATE orders SCHEMA price FLOAT32 DEFAULT 1.5 number UINT32 desc STRING DEFAULT "none";`), "OK (")
+	require.Contains(t, run(`IDX.INSERT orders 1 30.5 3 "red apple"`), "OK (")
+	require.Contains(t, run(`IDX.INSERT orders 2 12.25 4 "green pear"`), "OK (")
+	require.Contains(t, run(`IDX.INSERT orders 3 number=5`), "OK (")
+	require.Contains(t, run(`IDX.INSERT orders 1 1 1 "dup"`), "ERROR: ")
+	require.Contains(t, run(`IDX.SELECT orders WHERE desc CONTAINS "apple"`), "(1 rows, ")
+	require.Contains(t, run(`EXPLAIN IDX.SELECT orders WHERE desc CONTAINS "apple"`), "  all matching documents are returned\n")
+	require.Contains(t, run(`IDX.DEL orders 2 12.25 4 "green pear"`), "DELETED 1 (")
+	require.Equal(t, "", run("-- a comment"))
+	require.Equal(t, "", run("  "))
+
+	//TESTCASE: FLOAT values of the ORDERBY property are decoded
+	res := run(`IDX.SELECT orders WHERE price>=1 ORDERBY price LIMIT 10`)
+	require.Contains(t, res, "30.5")
+	require.Contains(t, res, "1.5")
+	require.Contains(t, res, "(2 rows, ")
+
+	//TESTCASE: \d lists and describes indices, with the FLOAT default decoded
+	require.Equal(t, "orders\n", run(`\d`))
+	res = run(`\d orders`)
+	require.Contains(t, res, `Index "orders" (version 1, store documents: false)`)
+	lines := strings.Split(res, "\n")
+	require.Equal(t, 6, len(lines))
+	require.Contains(t, lines[2], "price")
+	require.Contains(t, lines[2], "FLOAT32")
+	require.Contains(t, lines[2], "1.5")
+	require.Contains(t, lines[3], "UINT32")
+	require.Contains(t, lines[4], `"none"`)
+	require.Contains(t, run(`\d addrs`), "ERROR: ")
+
+	//TESTCASE: EXPLAIN prints the evaluation steps without executing
+	res = run(`EXPLAIN IDX.SELECT orders WHERE price>=10 price<=20.5 desc CONTAINS "apple" number IS NULL`)
+	require.Equal(t, `Select on index orders, slice by slice:
+  1. live documents
+  2. intersect desc CONTAINS "apple"
+  3. subtract number EXISTS (IS NULL)
+  4. intersect price BETWEEN 10 AND 20.5
+  5. keep the top 100 ORDERBY price
+`, res)
+	require.Contains(t, run(`EXPLAIN IDX.SELECT orders WHERE price>=1 ORDERBY price LIMIT 5`), "  3. keep the top 5 ORDERBY price\n")
+	require.Contains(t, run(`EXPLAIN IDX.SELECT orders WHERE desc CONTAINS "apple" HIGHLIGHT`), "  then the terms of STRING predicates are highlighted in the stored documents\n")
+	require.Contains(t, run(`EXPLAIN IDX.DESTROY orders`), "*cql.CqlDestroy")
+	require.Equal(t, "orders\n", run(`\d`))
+
+	//TESTCASE: \s shows history, !! and !<n> execute history again
+	res = run(`\s`)
+	require.Contains(t, res, "    1  IDX.CREATE orders SCHEMA")
+	require.Contains(t, res, "    6  IDX.SELECT orders WHERE desc CONTAINS \"apple\"\n")
+	numHistory := len(sh.history)
+	res = run("!!")
+	require.True(t, strings.HasPrefix(res, "EXPLAIN IDX.DESTROY orders\n"))
+	res = run("!6")
+	require.True(t, strings.HasPrefix(res, "IDX.SELECT orders WHERE desc CONTAINS \"apple\"\n"))
+	require.Contains(t, res, "(1 rows, ")
+	require.Equal(t, numHistory+2, len(sh.history))
+	require.Contains(t, run("!100"), "ERROR: no such history entry !100")
+	require.Contains(t, run("!x"), "ERROR: no such history entry !x")
+	require.Equal(t, numHistory+2, len(sh.history))
+
+	//TESTCASE: \q quits
+	out.Reset()
+	require.Equal(t, true, sh.handleLine(`\q`))
+}
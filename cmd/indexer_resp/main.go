@@ -0,0 +1,61 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/deepfabric/indexer"
+)
+
+var (
+	addr        = flag.String("addr", ":6380", "Redis protocol listen address")
+	dir         = flag.String("dir", "/tmp/indexer", "main directory of the indexer")
+	enableWal   = flag.Bool("wal", true, "write all operations to WAL, and replay it at start")
+	repairWal   = flag.Bool("repair-wal", false, "truncate a torn write at the tail of WAL instead of failing at start")
+	compressWal = flag.Bool("compress-wal", false, "compress WAL segments")
+	parallelism = flag.Int("parallelism", 0, "max number of slices a query evaluates concurrently, 0 means GOMAXPROCS")
+)
+
+func main() {
+	flag.Parse()
+
+	ir, err := indexer.NewIndexerExt(*dir, indexer.IndexerOptions{
+		EnableWal:        *enableWal,
+		RepairWal:        *repairWal,
+		CompressWal:      *compressWal,
+		QueryParallelism: *parallelism,
+	})
+	if err != nil {
+		log.Fatalf("%+v", err)
+	}
+
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatalf("%+v", err)
+	}
+	srv := newServer(ir)
+	errc := make(chan error, 1)
+	go func() {
+		errc <- srv.Serve(lis)
+	}()
+	log.Printf("indexer %v serving Redis protocol on %v", *dir, *addr)
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	select {
+	case err = <-errc:
+		log.Printf("server stopped: %v", err)
+	case sig := <-sigc:
+		log.Printf("received %v, shutting down", sig)
+		lis.Close()
+	}
+	srv.CloseConns()
+
+	if err = ir.Close(); err != nil {
+		log.Fatalf("%+v", err)
+	}
+}
@@ -0,0 +1,222 @@
+package main
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	maxArgs         = 1 << 20
+	maxPreallocArgs = 1 << 10
+	maxBulkLen      = 64 << 20
+	maxInlineLen    = 64 << 10
+)
+
+var (
+	errProtocol = errors.New("protocol error")
+)
+
+//respReader reads commands sent by Redis clients. A command is either a RESP array of bulk strings, or an inline command line.
+type respReader struct {
+	br *bufio.Reader
+}
+
+func newRespReader(r io.Reader) *respReader {
+	return &respReader{br: bufio.NewReader(r)}
+}
+
+//Buffered returns the number of bytes of pipelined commands which can be read without blocking.
+func (r *respReader) Buffered() int {
+	return r.br.Buffered()
+}
+
+//ReadCommand reads the arguments of the next command. An empty inline command results in no arguments.
+func (r *respReader) ReadCommand() (args []string, err error) {
+	var line string
+	if line, err = r.readLine(); err != nil {
+		return
+	}
+	if len(line) == 0 || line[0] != '*' {
+		if len(line) > maxInlineLen {
+			err = errors.Wrap(errProtocol, "too big inline request")
+			return
+		}
+		args, err = splitInline(line)
+		return
+	}
+	var n int
+	if n, err = parseLen(line[1:], maxArgs); err != nil {
+		return
+	}
+	//n comes from the client, so don't trust it for preallocation
+	if n <= maxPreallocArgs {
+		args = make([]string, 0, n)
+	}
+	for i := 0; i < n; i++ {
+		if line, err = r.readLine(); err != nil {
+			return
+		}
+		if len(line) == 0 || line[0] != '$' {
+			err = errors.Wrapf(errProtocol, "expected '$', got %q", line)
+			return
+		}
+		var size int
+		if size, err = parseLen(line[1:], maxBulkLen); err != nil {
+			return
+		}
+		var arg string
+		if arg, err = r.readBulk(size); err != nil {
+			return
+		}
+		args = append(args, arg)
+	}
+	return
+}
+
+//readBulk reads a bulk string of the given size and the trailing CRLF. The buffer grows as the data arrives
+//instead of being allocated upfront, since size comes from the client.
+func (r *respReader) readBulk(size int) (arg string, err error) {
+	var sb strings.Builder
+	if _, err = io.CopyN(&sb, r.br, int64(size)); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		err = errors.Wrap(err, "")
+		return
+	}
+	var crlf [2]byte
+	if _, err = io.ReadFull(r.br, crlf[:]); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	if crlf[0] != '\r' || crlf[1] != '\n' {
+		err = errors.Wrap(errProtocol, "bulk string isn't terminated by CRLF")
+		return
+	}
+	arg = sb.String()
+	return
+}
+
+//readLine reads a line without the trailing CRLF or LF.
+func (r *respReader) readLine() (line string, err error) {
+	if line, err = r.br.ReadString('\n'); err != nil {
+		if err != io.EOF {
+			err = errors.Wrap(err, "")
+		}
+		return
+	}
+	line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+	return
+}
+
+//splitInline splits an inline command line into arguments by spaces, and strips the double quotes of an argument as redis-cli does.
+//A quoted argument can contain spaces, \" and \\.
+func splitInline(line string) (args []string, err error) {
+	var arg []byte
+	inArg, inQuote := false, false
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case inQuote && c == '\\' && i+1 < len(line) && (line[i+1] == '"' || line[i+1] == '\\'):
+			i++
+			arg = append(arg, line[i])
+		case inQuote && c == '"':
+			if i+1 < len(line) && line[i+1] != ' ' && line[i+1] != '\t' {
+				err = errors.Wrap(errProtocol, "closing quote must be followed by a space")
+				return
+			}
+			inQuote = false
+		case inQuote:
+			arg = append(arg, c)
+		case c == ' ' || c == '\t':
+			if inArg {
+				args = append(args, string(arg))
+				arg, inArg = arg[:0], false
+			}
+		case c == '"':
+			inArg, inQuote = true, true
+		default:
+			inArg = true
+			arg = append(arg, c)
+		}
+	}
+	if inQuote {
+		err = errors.Wrap(errProtocol, "unbalanced quotes in request")
+		return
+	}
+	if inArg {
+		args = append(args, string(arg))
+	}
+	return
+}
+
+func parseLen(s string, max int) (n int, err error) {
+	if n, err = strconv.Atoi(s); err != nil || n < 0 || n > max {
+		err = errors.Wrapf(errProtocol, "invalid length %q", s)
+	}
+	return
+}
+
+//respWriter writes RESP replies. Replies are buffered until Flush.
+type respWriter struct {
+	bw *bufio.Writer
+}
+
+func newRespWriter(w io.Writer) *respWriter {
+	return &respWriter{bw: bufio.NewWriter(w)}
+}
+
+func (w *respWriter) WriteSimpleString(s string) {
+	w.bw.WriteByte('+')
+	w.bw.WriteString(s)
+	w.bw.WriteString("\r\n")
+}
+
+//WriteError writes an error reply. CR and LF in msg are replaced since they terminate the reply.
+func (w *respWriter) WriteError(msg string) {
+	w.bw.WriteByte('-')
+	w.bw.WriteString(strings.NewReplacer("\r", " ", "\n", " ").Replace(msg))
+	w.bw.WriteString("\r\n")
+}
+
+func (w *respWriter) WriteInt(n int64) {
+	w.bw.WriteByte(':')
+	w.bw.WriteString(strconv.FormatInt(n, 10))
+	w.bw.WriteString("\r\n")
+}
+
+func (w *respWriter) WriteUint(n uint64) {
+	w.bw.WriteByte(':')
+	w.bw.WriteString(strconv.FormatUint(n, 10))
+	w.bw.WriteString("\r\n")
+}
+
+func (w *respWriter) WriteBulk(s string) {
+	w.bw.WriteByte('$')
+	w.bw.WriteString(strconv.Itoa(len(s)))
+	w.bw.WriteString("\r\n")
+	w.bw.WriteString(s)
+	w.bw.WriteString("\r\n")
+}
+
+func (w *respWriter) WriteNil() {
+	w.bw.WriteString("$-1\r\n")
+}
+
+//WriteArrayLen writes the header of an array reply of n elements, which shall be written next.
+func (w *respWriter) WriteArrayLen(n int) {
+	w.bw.WriteByte('*')
+	w.bw.WriteString(strconv.Itoa(n))
+	w.bw.WriteString("\r\n")
+}
+
+func (w *respWriter) Flush() (err error) {
+	if err = w.bw.Flush(); err != nil {
+		err = errors.Wrap(err, "")
+	}
+	return
+}
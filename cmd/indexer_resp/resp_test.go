@@ -0,0 +1,133 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/deepfabric/indexer/cql"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitInline(t *testing.T) {
+	tcs := []struct {
+		line string
+		args []string
+		bad  bool
+	}{
+		{"", nil, false},
+		{"  \t ", nil, false},
+		{"PING", []string{"PING"}, false},
+		{"  IDX.SELECT  orders\tWHERE  ", []string{"IDX.SELECT", "orders", "WHERE"}, false},
+		{`IDX.INSERT orders 1 "hello world"`, []string{"IDX.INSERT", "orders", "1", "hello world"}, false},
+		{`a "" b`, []string{"a", "", "b"}, false},
+		{`"say \"hi\"" "a\\b"`, []string{`say "hi"`, `a\b`}, false},
+		{`"\n"`, []string{`\n`}, false},
+		{`a "b`, nil, true},
+		{`"a"b`, nil, true},
+	}
+	for _, tc := range tcs {
+		args, err := splitInline(tc.line)
+		if tc.bad {
+			require.Equalf(t, errProtocol, errors.Cause(err), "line %q", tc.line)
+			continue
+		}
+		require.NoErrorf(t, err, "line %q", tc.line)
+		require.Equalf(t, tc.args, args, "line %q", tc.line)
+	}
+}
+
+func TestReadCommand(t *testing.T) {
+	tcs := []struct {
+		input string
+		cmds  [][]string
+		err   error //the error after cmds
+	}{
+		{"*1\r\n$4\r\nPING\r\n", [][]string{{"PING"}}, io.EOF},
+		{"*2\r\n$4\r\nECHO\r\n$7\r\na\r\nb c \r\n", [][]string{{"ECHO", "a\r\nb c "}}, io.EOF},
+		{"*1\r\n$0\r\n\r\n", [][]string{{""}}, io.EOF},
+		{"*0\r\n", [][]string{{}}, io.EOF},
+		{"PING\r\n", [][]string{{"PING"}}, io.EOF},
+		{"PING\n\r\n", [][]string{{"PING"}, nil}, io.EOF},
+		{`IDX.DEL orders 1 "a b"` + "\r\n", [][]string{{"IDX.DEL", "orders", "1", "a b"}}, io.EOF},
+		//pipelined commands of both forms
+		{"*1\r\n$4\r\nPING\r\nPING\r\n*2\r\n$4\r\nECHO\r\n$1\r\nx\r\n", [][]string{{"PING"}, {"PING"}, {"ECHO", "x"}}, io.EOF},
+		//malformed
+		{"*x\r\n", nil, errProtocol},
+		{"*-1\r\n", nil, errProtocol},
+		{"*2000000\r\n", nil, errProtocol},
+		{"*1\r\n+PING\r\n", nil, errProtocol},
+		{"*1\r\n$-1\r\n", nil, errProtocol},
+		{"*1\r\n$100000000\r\n", nil, errProtocol},
+		{"*1\r\n$4\r\nPINGxx", nil, errProtocol},
+		{"*1\r\n$4\r\nPI", nil, io.ErrUnexpectedEOF},
+		{"*1\r\n$4\r\nPING\r", nil, io.ErrUnexpectedEOF},
+		{"*2\r\n$4\r\nECHO\r\n", nil, io.EOF},
+		{`a "b` + "\r\n", nil, errProtocol},
+		{strings.Repeat("a", maxInlineLen+1) + "\r\n", nil, errProtocol},
+	}
+	for _, tc := range tcs {
+		r := newRespReader(strings.NewReader(tc.input))
+		for _, cmd := range tc.cmds {
+			args, err := r.ReadCommand()
+			require.NoErrorf(t, err, "input %q", tc.input)
+			require.Equalf(t, cmd, args, "input %q", tc.input)
+		}
+		_, err := r.ReadCommand()
+		require.Equalf(t, tc.err, errors.Cause(err), "input %q", tc.input)
+	}
+
+	//TESTCASE: a big bulk string is read as a whole
+	big := bytes.Repeat([]byte("0123456789"), 100000)
+	input := "*1\r\n$" + "1000000" + "\r\n" + string(big) + "\r\n"
+	args, err := newRespReader(strings.NewReader(input)).ReadCommand()
+	require.NoError(t, err)
+	require.Equal(t, []string{string(big)}, args)
+}
+
+func TestJoinArgs(t *testing.T) {
+	docProts := map[string]*cql.Document{
+		"orders": &cql.Document{
+			UintProps: []*cql.UintProp{&cql.UintProp{Name: "price", ValLen: 4}},
+			EnumProps: []*cql.EnumProp{&cql.EnumProp{Name: "type"}},
+			StrProps:  []*cql.StrProp{&cql.StrProp{Name: "desc"}, &cql.StrProp{Name: "note"}},
+		},
+		"notes": &cql.Document{
+			StrProps: []*cql.StrProp{&cql.StrProp{Name: "desc"}},
+		},
+	}
+	tcs := []struct {
+		args []string
+		stmt string
+	}{
+		//positional
+		{[]string{"IDX.INSERT", "orders", "1", "30", "2", "red apple", "none"}, `IDX.INSERT orders 1 30 2 "red apple" "none"`},
+		{[]string{"IDX.DEL", "orders", "1", "30", "2", `"red apple"`, "none"}, `IDX.DEL orders 1 30 2 "red apple" "none"`},
+		{[]string{"IDX.INSERT", "notes", "1", "desc=apple"}, `IDX.INSERT notes 1 desc="apple"`},
+		{[]string{"IDX.INSERT", "notes", "1", `"desc=apple"`}, `IDX.INSERT notes 1 "desc=apple"`},
+		{[]string{"IDX.INSERT", "orders", "1", "30", "2", "note=x", "y"}, `IDX.INSERT orders 1 30 2 "note=x" "y"`},
+		//named
+		{[]string{"IDX.INSERT", "orders", "1", "price=30", "desc=red apple"}, `IDX.INSERT orders 1 price=30 desc="red apple"`},
+		{[]string{"IDX.INSERT", "orders", "1", "note=a=b", "type=2"}, `IDX.INSERT orders 1 note="a=b" type=2`},
+		{[]string{"IDX.INSERT", "notes", "1", "desc=a", "desc=b"}, `IDX.INSERT notes 1 "desc=a" "desc=b"`},
+		//quoted
+		{[]string{"IDX.INSERT", "notes", "1", `say "hi"`}, `IDX.INSERT notes 1 "say \"hi\""`},
+		{[]string{"IDX.INSERT", "notes", "1", `a\b`}, `IDX.INSERT notes 1 "a\\b"`},
+		{[]string{"IDX.INSERT", "notes", "1", `"a" "b"`}, `IDX.INSERT notes 1 "\"a\" \"b\""`},
+		{[]string{"IDX.INSERT", "notes", "1", `"a\"`}, `IDX.INSERT notes 1 "\"a\\\""`},
+		{[]string{"IDX.INSERT", "notes", "1", `"`}, `IDX.INSERT notes 1 "\""`},
+		{[]string{"IDX.INSERT", "unknown", "1", "a b"}, `IDX.INSERT unknown 1 a b`},
+		//CONTAINS
+		{[]string{"IDX.SELECT", "orders", "WHERE", "price>=30", "desc", "CONTAINS", "apple"}, `IDX.SELECT orders WHERE price>=30 desc CONTAINS "apple"`},
+		{[]string{"IDX.SELECT", "orders", "WHERE", "desc", "CONTAINS", `"apple"`}, `IDX.SELECT orders WHERE desc CONTAINS "apple"`},
+		{[]string{"IDX.SELECT", "orders", "WHERE", "desc", "CONTAINS", `x" price>=0 "`}, `IDX.SELECT orders WHERE desc CONTAINS "x\" price>=0 \""`},
+		//DEFAULT
+		{[]string{"IDX.CREATE", "orders", "SCHEMA", "price", "UINT32", "DEFAULT", "7", "desc", "STRING", "DEFAULT", "none"}, `IDX.CREATE orders SCHEMA price UINT32 DEFAULT 7 desc STRING DEFAULT "none"`},
+		{[]string{"IDX.ALTER", "orders", "ADD", "memo", "STRING", "DEFAULT", "n/a"}, `IDX.ALTER orders ADD memo STRING DEFAULT "n/a"`},
+	}
+	for _, tc := range tcs {
+		require.Equalf(t, tc.stmt, joinArgs(tc.args, docProts), "args %q", tc.args)
+	}
+}
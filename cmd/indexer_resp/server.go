@@ -0,0 +1,324 @@
+package main
+
+import (
+	"io"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/deepfabric/bkdtree"
+	"github.com/deepfabric/indexer"
+	"github.com/deepfabric/indexer/cql"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+//server exposes an Indexer over the Redis protocol.
+//IDX.CREATE, IDX.DESTROY, IDX.ALTER, IDX.INSERT, IDX.DEL and IDX.SELECT (or QUERY) are CQL statements.
+//IDX.LIST, IDX.SUMMARY and IDX.SYNC are admin commands.
+type server struct {
+	ir    *indexer.Indexer
+	mu    sync.Mutex
+	conns map[net.Conn]struct{}
+	wg    sync.WaitGroup
+}
+
+func newServer(ir *indexer.Indexer) *server {
+	return &server{ir: ir, conns: make(map[net.Conn]struct{})}
+}
+
+//Serve serves the connections accepted by lis until lis is closed.
+func (s *server) Serve(lis net.Listener) (err error) {
+	for {
+		var conn net.Conn
+		if conn, err = lis.Accept(); err != nil {
+			err = errors.Wrap(err, "")
+			return
+		}
+		s.mu.Lock()
+		s.conns[conn] = struct{}{}
+		s.mu.Unlock()
+		s.wg.Add(1)
+		go s.serveConn(conn)
+	}
+}
+
+//CloseConns closes all connections, and waits for the commands in progress.
+func (s *server) CloseConns() {
+	s.mu.Lock()
+	for conn := range s.conns {
+		conn.Close()
+	}
+	s.mu.Unlock()
+	s.wg.Wait()
+}
+
+func (s *server) serveConn(conn net.Conn) {
+	defer func() {
+		conn.Close()
+		s.mu.Lock()
+		delete(s.conns, conn)
+		s.mu.Unlock()
+		s.wg.Done()
+	}()
+	r := newRespReader(conn)
+	w := newRespWriter(conn)
+	for {
+		args, err := r.ReadCommand()
+		if err != nil {
+			if errors.Cause(err) == errProtocol {
+				w.WriteError("ERR " + err.Error())
+				w.Flush()
+			} else if err != io.EOF {
+				log.Debugf("connection %v: %v", conn.RemoteAddr(), err)
+			}
+			return
+		}
+		if len(args) == 0 {
+			continue
+		}
+		quit := s.exec(w, args)
+		//flush once all pipelined commands are answered
+		if quit || r.Buffered() == 0 {
+			if err = w.Flush(); err != nil {
+				log.Debugf("connection %v: %v", conn.RemoteAddr(), err)
+				return
+			}
+		}
+		if quit {
+			return
+		}
+	}
+}
+
+//exec executes a command and writes the reply. quit is true if the connection shall be closed.
+func (s *server) exec(w *respWriter, args []string) (quit bool) {
+	cmd := strings.ToUpper(args[0])
+	switch cmd {
+	case "PING":
+		if len(args) > 1 {
+			w.WriteBulk(args[1])
+		} else {
+			w.WriteSimpleString("PONG")
+		}
+	case "ECHO":
+		if len(args) != 2 {
+			w.WriteError("ERR wrong number of arguments for 'echo' command")
+			return
+		}
+		w.WriteBulk(args[1])
+	case "QUIT":
+		w.WriteSimpleString("OK")
+		quit = true
+	case "COMMAND":
+		//redis-cli asks for command docs at start, there's none.
+		w.WriteArrayLen(0)
+	case "IDX.CREATE", "IDX.DESTROY", "IDX.ALTER", "IDX.INSERT", "IDX.DEL", "IDX.SELECT", "QUERY":
+		args[0] = cmd
+		s.execCql(w, args)
+	case "IDX.LIST":
+		names := s.ir.IndexNames()
+		w.WriteArrayLen(len(names))
+		for _, name := range names {
+			w.WriteBulk(name)
+		}
+	case "IDX.SUMMARY":
+		sum, err := s.ir.Summary()
+		if err != nil {
+			writeErr(w, err)
+			return
+		}
+		w.WriteBulk(sum)
+	case "IDX.SYNC":
+		if err := s.ir.Sync(); err != nil {
+			writeErr(w, err)
+			return
+		}
+		w.WriteSimpleString("OK")
+	default:
+		w.WriteError("ERR unknown command '" + args[0] + "'")
+	}
+	return
+}
+
+//execCql executes a CQL statement.
+//The reply of IDX.DEL is 1 if the document is found, otherwise 0.
+//The reply of IDX.SELECT is an array of docIDs, in the order of the ORDERBY property if given.
+func (s *server) execCql(w *respWriter, args []string) {
+	docProts := s.docProts()
+	res, err := cql.ParseCql(joinArgs(args, docProts), docProts)
+	if err != nil {
+		w.WriteError("ERR " + err.Error())
+		return
+	}
+	switch q := res.(type) {
+	case *cql.CqlCreate:
+		err = s.ir.CreateIndex(&q.DocumentWithIdx)
+	case *cql.CqlDestroy:
+		err = s.ir.DestroyIndex(q.Index)
+	case *cql.CqlAlter:
+		err = s.ir.AlterIndex(q)
+	case *cql.CqlInsert:
+		err = s.ir.Insert(&q.DocumentWithIdx)
+	case *cql.CqlDel:
+		var found bool
+		if found, err = s.ir.Del(q.Index, q.Doc.DocID); err == nil {
+			if found {
+				w.WriteInt(1)
+			} else {
+				w.WriteInt(0)
+			}
+			return
+		}
+	case *cql.CqlSelect:
+		var qr *indexer.QueryResult
+		if qr, err = s.ir.Select(q); err == nil {
+			writeQueryResult(w, q, qr)
+			return
+		}
+	default:
+		err = errors.Errorf("unsupported statement %T", res)
+	}
+	if err != nil {
+		writeErr(w, err)
+		return
+	}
+	w.WriteSimpleString("OK")
+}
+
+//docProts returns the schemas of all indices in the form cql.ParseCql accepts.
+func (s *server) docProts() (docProts map[string]*cql.Document) {
+	docProts = make(map[string]*cql.Document)
+	for _, name := range s.ir.IndexNames() {
+		if docProt := s.ir.GetDocProt(name); docProt != nil {
+			docProts[name] = &docProt.Doc
+		}
+	}
+	return
+}
+
+func writeQueryResult(w *respWriter, q *cql.CqlSelect, qr *indexer.QueryResult) {
+	if q.OrderBy == "" {
+		docIDs := qr.Bm.Bits()
+		w.WriteArrayLen(len(docIDs))
+		for _, docID := range docIDs {
+			w.WriteUint(docID)
+		}
+		return
+	}
+	items := qr.Oa.Finalize()
+	w.WriteArrayLen(len(items))
+	for _, item := range items {
+		w.WriteUint(item.(bkdtree.Point).UserData)
+	}
+}
+
+//writeErr writes err as an error reply. The prefix is derived from the cause as Redis does.
+func writeErr(w *respWriter, err error) {
+	prefix := "ERR"
+	switch errors.Cause(err) {
+	case indexer.ErrIdxNotExist, indexer.ErrNoDocStore:
+		prefix = "NOTFOUND"
+	case indexer.ErrIdxExist, indexer.ErrSchemaMismatch, indexer.ErrDocExist, indexer.ErrPropExist:
+		prefix = "EXISTS"
+	case indexer.ErrUnknownProp:
+		//a bad request, not worth logging
+	default:
+		log.Errorf("%+v", err)
+	}
+	w.WriteError(prefix + " " + err.Error())
+}
+
+//joinArgs rebuilds the CQL statement from the arguments of a command.
+//Clients such as redis-cli strip the quotes of string literals, so arguments at the places of string literals are quoted again unless they are already quoted:
+//	the word after CONTAINS, and after STRING DEFAULT
+//	values of STRING properties of IDX.INSERT and IDX.DEL, either positional or in the form name=value
+//Values are taken as named only if all of them are in the form name=value with distinct properties of the index.
+//A positional value of such form shall be quoted.
+func joinArgs(args []string, docProts map[string]*cql.Document) string {
+	quoted := make([]string, len(args))
+	copy(quoted, args)
+	switch args[0] {
+	case "IDX.INSERT", "IDX.DEL":
+		if len(args) < 3 {
+			break
+		}
+		docProt, ok := docProts[args[1]]
+		if !ok {
+			break
+		}
+		props := make(map[string]bool)
+		for _, uintProp := range docProt.UintProps {
+			props[uintProp.Name] = false
+		}
+		for _, enumProp := range docProt.EnumProps {
+			props[enumProp.Name] = false
+		}
+		for _, strProp := range docProt.StrProps {
+			props[strProp.Name] = true
+		}
+		//values are either all positional or all named
+		if !isNamed(args[3:], props) {
+			for i := 3 + len(docProt.UintProps) + len(docProt.EnumProps); i < len(args); i++ {
+				quoted[i] = quote(args[i])
+			}
+			break
+		}
+		for i := 3; i < len(args); i++ {
+			if pos := strings.Index(args[i], "="); props[args[i][:pos]] {
+				quoted[i] = args[i][:pos+1] + quote(args[i][pos+1:])
+			}
+		}
+	default:
+		for i := 1; i < len(args); i++ {
+			if args[i-1] == "CONTAINS" || (i >= 2 && args[i-2] == "STRING" && args[i-1] == "DEFAULT") {
+				quoted[i] = quote(args[i])
+			}
+		}
+	}
+	return strings.Join(quoted, " ")
+}
+
+//isNamed returns whether all values are in the form name=value with distinct properties.
+func isNamed(vals []string, props map[string]bool) bool {
+	seen := make(map[string]bool)
+	for _, val := range vals {
+		pos := strings.Index(val, "=")
+		if pos <= 0 {
+			return false
+		}
+		name := val[:pos]
+		if _, ok := props[name]; !ok || seen[name] {
+			return false
+		}
+		seen[name] = true
+	}
+	return len(vals) != 0
+}
+
+//quote returns s as a CQL string literal. s is kept as is if it's already one.
+func quote(s string) string {
+	if isStringLit(s) {
+		return s
+	}
+	return "\"" + strings.NewReplacer("\\", "\\\\", "\"", "\\\"").Replace(s) + "\""
+}
+
+//isStringLit returns whether s is double quoted, and the double quotes and backslashes inside are escaped.
+func isStringLit(s string) bool {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return false
+	}
+	for i := 1; i < len(s)-1; i++ {
+		switch s[i] {
+		case '\\':
+			i++
+			if i == len(s)-1 {
+				return false
+			}
+		case '"':
+			return false
+		}
+	}
+	return true
+}
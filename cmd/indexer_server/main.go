@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/deepfabric/indexer"
+)
+
+var (
+	addr        = flag.String("addr", ":8080", "HTTP listen address")
+	dir         = flag.String("dir", "/tmp/indexer", "main directory of the indexer")
+	enableWal   = flag.Bool("wal", true, "write all operations to WAL, and replay it at start")
+	repairWal   = flag.Bool("repair-wal", false, "truncate a torn write at the tail of WAL instead of failing at start")
+	compressWal = flag.Bool("compress-wal", false, "compress WAL segments")
+	parallelism = flag.Int("parallelism", 0, "max number of slices a query evaluates concurrently, 0 means GOMAXPROCS")
+	snapRoot    = flag.String("snapshot-root", "", "directory under which POST /snapshot creates snapshots, empty disables it")
+)
+
+func main() {
+	flag.Parse()
+
+	ir, err := indexer.NewIndexerExt(*dir, indexer.IndexerOptions{
+		EnableWal:        *enableWal,
+		RepairWal:        *repairWal,
+		CompressWal:      *compressWal,
+		QueryParallelism: *parallelism,
+	})
+	if err != nil {
+		log.Fatalf("%+v", err)
+	}
+
+	srv := &http.Server{Addr: *addr, Handler: newServer(ir, *snapRoot)}
+	errc := make(chan error, 1)
+	go func() {
+		errc <- srv.ListenAndServe()
+	}()
+	log.Printf("indexer %v serving on %v", *dir, *addr)
+
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	select {
+	case err = <-errc:
+		log.Printf("server stopped: %v", err)
+	case sig := <-sigc:
+		log.Printf("received %v, shutting down", sig)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		if err = srv.Shutdown(ctx); err != nil {
+			log.Printf("failed to shut down gracefully: %v", err)
+		}
+		cancel()
+	}
+
+	if err = ir.Close(); err != nil {
+		log.Fatalf("%+v", err)
+	}
+}
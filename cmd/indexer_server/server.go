@@ -0,0 +1,346 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/deepfabric/indexer"
+	"github.com/deepfabric/indexer/coordinator"
+	"github.com/deepfabric/indexer/cql"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	maxCqlBytes = 1 << 20
+)
+
+//server exposes an Indexer over HTTP/JSON.
+type server struct {
+	ir       *indexer.Indexer
+	mux      *http.ServeMux
+	snapRoot string //the directory under which POST /snapshot creates snapshots. empty disables POST /snapshot.
+}
+
+//result is the JSON response of operations other than select.
+type result struct {
+	Found *bool  `json:"found,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+//snapshotRequest is the JSON body of POST /snapshot.
+type snapshotRequest struct {
+	Dir string `json:"dir"` //relative to the snapshot root
+}
+
+func newServer(ir *indexer.Indexer, snapRoot string) (s *server) {
+	s = &server{ir: ir, mux: http.NewServeMux(), snapRoot: snapRoot}
+	s.mux.HandleFunc("/cql", s.handleCql)
+	s.mux.HandleFunc("/indices", s.handleIndices)
+	s.mux.HandleFunc("/indices/", s.handleIndex)
+	s.mux.HandleFunc("/sync", s.handleSync)
+	s.mux.HandleFunc("/snapshot", s.handleSnapshot)
+	s.mux.HandleFunc("/summary", s.handleSummary)
+	s.mux.Handle(coordinator.SelectPath, coordinator.NewHandler(ir))
+	return
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+//docProts returns the schemas of all indices in the form cql.ParseCql accepts.
+func (s *server) docProts() (docProts map[string]*cql.Document) {
+	docProts = make(map[string]*cql.Document)
+	for _, name := range s.ir.IndexNames() {
+		if docProt := s.ir.GetDocProt(name); docProt != nil {
+			docProts[name] = &docProt.Doc
+		}
+	}
+	return
+}
+
+//handleCql executes the CQL statement in the request body.
+func (s *server) handleCql(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxCqlBytes))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	res, err := cql.ParseCql(strings.TrimSpace(string(body)), s.docProts())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	switch q := res.(type) {
+	case *cql.CqlCreate:
+		err = s.ir.CreateIndex(&q.DocumentWithIdx)
+	case *cql.CqlDestroy:
+		err = s.ir.DestroyIndex(q.Index)
+	case *cql.CqlAlter:
+		err = s.ir.AlterIndex(q)
+	case *cql.CqlInsert:
+		err = s.ir.Insert(&q.DocumentWithIdx)
+	case *cql.CqlDel:
+		var found bool
+		if found, err = s.ir.Del(q.Index, q.Doc.DocID); err == nil {
+			writeJSON(w, http.StatusOK, &result{Found: &found})
+			return
+		}
+	case *cql.CqlSelect:
+		var qr *indexer.QueryResult
+		if qr, err = s.ir.Select(q); err == nil {
+			writeJSON(w, http.StatusOK, coordinator.NewSelectResponse(qr))
+			return
+		}
+	default:
+		err = errors.Errorf("unsupported statement %T", res)
+	}
+	writeResult(w, err)
+}
+
+//handleIndices lists the schemas of all indices.
+func (s *server) handleIndices(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+	docProts := make([]*cql.DocumentWithIdx, 0)
+	for _, name := range s.ir.IndexNames() {
+		if docProt := s.ir.GetDocProt(name); docProt != nil {
+			docProts = append(docProts, docProt)
+		}
+	}
+	writeJSON(w, http.StatusOK, docProts)
+}
+
+//handleIndex serves an index and its documents:
+//	GET /indices/<index>, DELETE /indices/<index>
+//	POST /indices/<index>/docs with a JSON cql.Document
+//	GET /indices/<index>/docs/<docID>, DELETE /indices/<index>/docs/<docID>
+func (s *server) handleIndex(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/indices/"), "/"), "/")
+	name := parts[0]
+	switch {
+	case len(parts) == 1:
+		s.handleSchema(w, r, name)
+	case len(parts) == 2 && parts[1] == "docs" && r.Method == http.MethodPost:
+		var doc cql.DocumentWithIdx
+		if err := json.NewDecoder(r.Body).Decode(&doc.Doc); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		doc.Index = name
+		writeResult(w, s.ir.Insert(&doc))
+	case len(parts) == 3 && parts[1] == "docs":
+		docID, err := strconv.ParseUint(parts[2], 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		s.handleDoc(w, r, name, docID)
+	default:
+		writeError(w, http.StatusNotFound, errors.Errorf("unknown path %v", r.URL.Path))
+	}
+}
+
+func (s *server) handleSchema(w http.ResponseWriter, r *http.Request, name string) {
+	switch r.Method {
+	case http.MethodGet:
+		docProt := s.ir.GetDocProt(name)
+		if docProt == nil {
+			writeResult(w, errors.Wrap(indexer.ErrIdxNotExist, name))
+			return
+		}
+		writeJSON(w, http.StatusOK, docProt)
+	case http.MethodDelete:
+		writeResult(w, s.ir.DestroyIndex(name))
+	default:
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+	}
+}
+
+func (s *server) handleDoc(w http.ResponseWriter, r *http.Request, name string, docID uint64) {
+	switch r.Method {
+	case http.MethodGet:
+		doc, found, err := s.ir.Get(name, docID)
+		if err == nil && !found {
+			err = errors.Errorf("document %v not found in index %v", docID, name)
+			writeError(w, http.StatusNotFound, err)
+			return
+		}
+		if err != nil {
+			writeResult(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, doc)
+	case http.MethodDelete:
+		found, err := s.ir.Del(name, docID)
+		if err != nil {
+			writeResult(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, &result{Found: &found})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+	}
+}
+
+//handleSync persists all indices.
+func (s *server) handleSync(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+	writeResult(w, s.ir.Sync())
+}
+
+//handleSnapshot serves snapshots:
+//	GET /snapshot sends a snapshot archive of all indices
+//	PUT /snapshot replaces all indices with the snapshot archive in the request body. A truncated or corrupted
+//	archive is rejected by ReadSnapshot with the indices intact.
+//	POST /snapshot with a JSON snapshotRequest creates a snapshot in a directory under the snapshot root, and returns the manifest
+func (s *server) handleSnapshot(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		s.sendSnapshot(w)
+	case http.MethodPut:
+		writeResult(w, s.ir.ReadSnapshot(r.Body))
+	case http.MethodPost:
+		if s.snapRoot == "" {
+			writeError(w, http.StatusForbidden, errors.New("snapshot root is not configured"))
+			return
+		}
+		var req snapshotRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Dir == "" {
+			writeError(w, http.StatusBadRequest, errors.Errorf("a snapshot directory is required: %v", err))
+			return
+		}
+		snapDir, err := s.snapshotDir(req.Dir)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		manifest, _, err := s.ir.CreateSnapshotExt(snapDir, indexer.SnapshotOptions{})
+		if err != nil {
+			writeResult(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, manifest)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+	}
+}
+
+//sendSnapshot buffers a snapshot archive to a temporary file, and sends it. So a failure is reported with
+//an error status instead of a truncated archive.
+func (s *server) sendSnapshot(w http.ResponseWriter) {
+	f, err := ioutil.TempFile("", "indexer_snapshot")
+	if err != nil {
+		writeResult(w, errors.Wrap(err, ""))
+		return
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	if err = s.ir.WriteSnapshot(f); err != nil {
+		writeResult(w, err)
+		return
+	}
+	var size int64
+	if size, err = f.Seek(0, io.SeekCurrent); err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		writeResult(w, errors.Wrap(err, ""))
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-tar")
+	w.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+	if _, err = io.Copy(w, f); err != nil {
+		log.Warnf("failed to send snapshot: %v", err)
+	}
+}
+
+//snapshotDir resolves dir, which is relative to the snapshot root, and rejects the ones escaping the root or
+//overlapping MainDir, since CreateSnapshotExt clears the snapshot directory.
+func (s *server) snapshotDir(dir string) (snapDir string, err error) {
+	rel := filepath.Clean(dir)
+	if filepath.IsAbs(rel) || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		err = errors.Errorf("snapshot directory %v shall be relative to the snapshot root without ..", dir)
+		return
+	}
+	var root, mainDir string
+	if root, err = filepath.Abs(s.snapRoot); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	if mainDir, err = filepath.Abs(s.ir.MainDir); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	snapDir = filepath.Join(root, rel)
+	if isWithin(snapDir, mainDir) || isWithin(mainDir, snapDir) {
+		err = errors.Errorf("snapshot directory %v overlaps the main directory %v", snapDir, mainDir)
+		return
+	}
+	return
+}
+
+//isWithin returns whether the cleaned absolute path p is dir or under it.
+func isWithin(p, dir string) bool {
+	return p == dir || strings.HasPrefix(p, dir+string(filepath.Separator))
+}
+
+//handleSummary returns the number of documents of each index as text.
+func (s *server) handleSummary(w http.ResponseWriter, r *http.Request) {
+	sum, err := s.ir.Summary()
+	if err != nil {
+		writeResult(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Write([]byte(sum))
+}
+
+//writeResult writes an empty result on success, or the error with a status derived from it.
+func writeResult(w http.ResponseWriter, err error) {
+	if err == nil {
+		writeJSON(w, http.StatusOK, &result{})
+		return
+	}
+	status := http.StatusInternalServerError
+	switch errors.Cause(err) {
+	case indexer.ErrIdxNotExist, indexer.ErrNoDocStore:
+		status = http.StatusNotFound
+	case indexer.ErrIdxExist, indexer.ErrSchemaMismatch, indexer.ErrDocExist, indexer.ErrPropExist:
+		status = http.StatusConflict
+	case indexer.ErrUnknownProp, indexer.ErrIncompleteSnapshot, indexer.ErrChecksumMismatch, indexer.ErrBadSnapshotArchive, io.ErrUnexpectedEOF:
+		status = http.StatusBadRequest
+	}
+	writeError(w, status, err)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	if status == http.StatusInternalServerError {
+		log.Errorf("%+v", err)
+	}
+	writeJSON(w, status, &result{Error: err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Warnf("failed to write response: %v", err)
+	}
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/deepfabric/indexer"
+	"github.com/deepfabric/indexer/coordinator"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testDir = "/tmp/indexer_server_test"
+)
+
+func newTestServer(t *testing.T) (ir *indexer.Indexer, s *server) {
+	err := os.RemoveAll(testDir)
+	require.NoError(t, err)
+	ir, err = indexer.NewIndexer(filepath.Join(testDir, "main"), true, false)
+	require.NoError(t, err)
+	s = newServer(ir, filepath.Join(testDir, "snap"))
+	return
+}
+
+func doRequest(s *server, method, path string, body []byte) (rec *httptest.ResponseRecorder) {
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(method, path, bytes.NewReader(body)))
+	return
+}
+
+func doCql(t *testing.T, s *server, stmt string) (rec *httptest.ResponseRecorder) {
+	rec = doRequest(s, http.MethodPost, "/cql", []byte(stmt))
+	require.Equalf(t, http.StatusOK, rec.Code, "%v: %v", stmt, rec.Body.String())
+	return
+}
+
+//selectDocIDs returns the docIDs of a select in order. A select with a UintPred is ordered by the first one, and returns points.
+func selectDocIDs(t *testing.T, s *server, stmt string) (docIDs []uint64) {
+	var resp coordinator.SelectResponse
+	rec := doCql(t, s, stmt)
+	err := json.Unmarshal(rec.Body.Bytes(), &resp)
+	require.NoError(t, err)
+	docIDs = resp.Bits
+	for _, point := range resp.Points {
+		docIDs = append(docIDs, point.DocID)
+	}
+	sort.Slice(docIDs, func(i, j int) bool { return docIDs[i] < docIDs[j] })
+	return
+}
+
+func TestServerCql(t *testing.T) {
+	ir, s := newTestServer(t)
+	defer ir.Close()
+
+	doCql(t, s, "IDX.CREATE orders SCHEMA price UINT32 desc STRING")
+	for i := 0; i < 10; i++ {
+		doCql(t, s, fmt.Sprintf("IDX.INSERT orders %d %d \"order %d\"", i, i*10, i))
+	}
+
+	//TESTCASE: select by range and by word
+	require.Equal(t, []uint64{3, 4, 5}, selectDocIDs(t, s, "IDX.SELECT orders WHERE price>=30 price<=50"))
+	require.Equal(t, []uint64{7}, selectDocIDs(t, s, "IDX.SELECT orders WHERE desc CONTAINS \"7\""))
+
+	//TESTCASE: deletion reports whether the document was there
+	var res result
+	rec := doCql(t, s, "IDX.DEL orders 3 30 \"order 3\"")
+	err := json.Unmarshal(rec.Body.Bytes(), &res)
+	require.NoError(t, err)
+	require.Equal(t, true, *res.Found)
+	require.Equal(t, []uint64{4, 5}, selectDocIDs(t, s, "IDX.SELECT orders WHERE price>=30 price<=50"))
+}
+
+func TestServerErrorStatus(t *testing.T) {
+	ir, s := newTestServer(t)
+	defer ir.Close()
+	doCql(t, s, "IDX.CREATE orders SCHEMA price UINT32")
+	doCql(t, s, "IDX.INSERT orders 1 10")
+
+	tcs := []struct {
+		method string
+		path   string
+		body   string
+		status int
+	}{
+		{http.MethodGet, "/cql", "", http.StatusMethodNotAllowed},
+		{http.MethodPost, "/cql", "IDX.BOGUS", http.StatusBadRequest},
+		{http.MethodPost, "/cql", "IDX.CREATE orders SCHEMA price UINT64", http.StatusConflict},
+		{http.MethodPost, "/cql", "IDX.INSERT orders 1 20", http.StatusConflict},
+		{http.MethodPost, "/cql", "IDX.SELECT addrs WHERE price>=10", http.StatusBadRequest},
+		{http.MethodGet, "/indices/addrs", "", http.StatusNotFound},
+		{http.MethodGet, "/indices/orders/docs/1", "", http.StatusNotFound},
+		{http.MethodGet, "/indices/orders/docs/abc", "", http.StatusBadRequest},
+		{http.MethodPost, "/indices/orders/docs", "{\"docID\":2,\"uintProps\":[{\"name\":\"weight\",\"valLen\":4}]}", http.StatusBadRequest},
+		{http.MethodGet, "/indices/orders/unknown/path", "", http.StatusNotFound},
+		{http.MethodGet, "/sync", "", http.StatusMethodNotAllowed},
+	}
+	for _, tc := range tcs {
+		rec := doRequest(s, tc.method, tc.path, []byte(tc.body))
+		require.Equalf(t, tc.status, rec.Code, "%v %v %v: %v", tc.method, tc.path, tc.body, rec.Body.String())
+		if tc.status != http.StatusOK {
+			var res result
+			err := json.Unmarshal(rec.Body.Bytes(), &res)
+			require.NoError(t, err)
+			require.NotEqual(t, "", res.Error)
+		}
+	}
+}
+
+func TestServerSnapshot(t *testing.T) {
+	ir, s := newTestServer(t)
+	defer ir.Close()
+	doCql(t, s, "IDX.CREATE orders SCHEMA price UINT32")
+	for i := 0; i < 10; i++ {
+		doCql(t, s, fmt.Sprintf("IDX.INSERT orders %d %d", i, i*10))
+	}
+	doRequest(s, http.MethodPost, "/sync", nil)
+
+	//TESTCASE: POST /snapshot creates a snapshot under the snapshot root
+	rec := doRequest(s, http.MethodPost, "/snapshot", []byte("{\"dir\":\"snap1\"}"))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	manifest, err := indexer.ReadSnapshotManifest(filepath.Join(testDir, "snap", "snap1"))
+	require.NoError(t, err)
+	require.NotEqual(t, 0, len(manifest.Files))
+
+	//TESTCASE: directories escaping the snapshot root or overlapping MainDir are rejected
+	for _, dir := range []string{"", "/tmp/snap", ".", "..", "../snap", "a/../../snap"} {
+		rec = doRequest(s, http.MethodPost, "/snapshot", []byte(fmt.Sprintf("{\"dir\":%q}", dir)))
+		require.Equalf(t, http.StatusBadRequest, rec.Code, "dir %q", dir)
+	}
+	s2 := newServer(ir, testDir)
+	for _, dir := range []string{"main", "main/index"} {
+		rec = doRequest(s2, http.MethodPost, "/snapshot", []byte(fmt.Sprintf("{\"dir\":%q}", dir)))
+		require.Equalf(t, http.StatusBadRequest, rec.Code, "dir %q", dir)
+	}
+	s3 := newServer(ir, filepath.Join(testDir, "main", "snap"))
+	rec = doRequest(s3, http.MethodPost, "/snapshot", []byte("{\"dir\":\"snap1\"}"))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	s4 := newServer(ir, "")
+	rec = doRequest(s4, http.MethodPost, "/snapshot", []byte("{\"dir\":\"snap1\"}"))
+	require.Equal(t, http.StatusForbidden, rec.Code)
+
+	//TESTCASE: an archive got by GET /snapshot restores the indices by PUT /snapshot
+	rec = doRequest(s, http.MethodGet, "/snapshot", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "application/x-tar", rec.Header().Get("Content-Type"))
+	archive := rec.Body.Bytes()
+	require.Equal(t, fmt.Sprintf("%d", len(archive)), rec.Header().Get("Content-Length"))
+	doCql(t, s, "IDX.INSERT orders 10 100")
+	require.Equal(t, 11, len(selectDocIDs(t, s, "IDX.SELECT orders WHERE price>=0")))
+	rec = doRequest(s, http.MethodPut, "/snapshot", archive)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t, 10, len(selectDocIDs(t, s, "IDX.SELECT orders WHERE price>=0")))
+
+	//TESTCASE: a truncated archive is rejected, and the indices are kept intact
+	rec = doRequest(s, http.MethodPut, "/snapshot", archive[:len(archive)/2])
+	require.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+	require.Equal(t, 10, len(selectDocIDs(t, s, "IDX.SELECT orders WHERE price>=0")))
+}
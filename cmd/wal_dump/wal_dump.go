@@ -0,0 +1,199 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/deepfabric/indexer/cql"
+	"github.com/deepfabric/indexer/wal"
+	"github.com/deepfabric/indexer/wal/walpb"
+	"github.com/pkg/errors"
+)
+
+var (
+	format    = flag.String("format", "text", "output format, text or json")
+	indexName = flag.String("index", "", "only dump entries of the given index")
+	docID     = flag.Int64("doc", -1, "only dump insertions, updates and deletions of the given docID")
+	from      = flag.Uint64("from", 0, "only dump entries whose index is at least this")
+	to        = flag.Uint64("to", 0, "only dump entries whose index is at most this, 0 means unlimited")
+)
+
+//payload is a decoded payload of an entry. A batch entry has one payload per batched entry.
+type payload struct {
+	Type  string               `json:"type"`
+	Doc   *cql.DocumentWithIdx `json:"doc,omitempty"`
+	Del   *cql.DocumentDel     `json:"del,omitempty"`
+	Index string               `json:"index,omitempty"`
+	Error string               `json:"error,omitempty"`
+}
+
+//record is a decoded WAL record.
+type record struct {
+	Type     string    `json:"type"`
+	Crc      uint32    `json:"crc"`
+	Term     uint64    `json:"term,omitempty"`
+	Index    uint64    `json:"index,omitempty"`
+	Entry    string    `json:"entry,omitempty"`
+	Payloads []payload `json:"payloads,omitempty"`
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: %s [flags] <wal dir>\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *format != "text" && *format != "json" {
+		log.Fatalf("unknown format %s", *format)
+	}
+	dir := flag.Arg(0)
+	if _, err := os.Stat(dir); err != nil {
+		log.Fatalf("path %s doesn't exists", dir)
+	}
+
+	first, err := wal.FirstIndex(dir)
+	if err != nil {
+		log.Fatalf("%+v", err)
+	}
+	w, err := wal.OpenForRead(dir, walpb.Snapshot{Index: first})
+	if err != nil {
+		log.Fatalf("%+v", err)
+	}
+	defer w.Close(false)
+
+	enc := json.NewEncoder(os.Stdout)
+	err = w.ReadRecords(func(rec *walpb.Record, ent *walpb.Entry) error {
+		r, ok := decodeRecord(rec, ent)
+		if !ok {
+			return nil
+		}
+		if *format == "json" {
+			return enc.Encode(r)
+		}
+		printRecord(r)
+		return nil
+	})
+	if errors.Cause(err) == io.ErrUnexpectedEOF {
+		log.Printf("the last record is torn: %+v", err)
+	} else if err != nil {
+		log.Fatalf("%+v", err)
+	}
+}
+
+//filtered tells whether any filter of entries is given.
+func filtered() bool {
+	return *indexName != "" || *docID >= 0 || *from != 0 || *to != 0
+}
+
+//decodeRecord decodes rec and returns whether it passes the filters.
+func decodeRecord(rec *walpb.Record, ent *walpb.Entry) (r record, ok bool) {
+	r = record{Type: wal.RecordTypeName(rec.Type), Crc: rec.Crc}
+	if ent == nil {
+		ok = !filtered()
+		return
+	}
+	if ent.Index < *from || (*to != 0 && ent.Index > *to) {
+		return
+	}
+	r.Term, r.Index, r.Entry = ent.Term, ent.Index, ent.Type.String()
+	data, err := walpb.UnwrapPayload(ent.Data)
+	if err != nil {
+		r.Payloads = []payload{{Type: r.Entry, Error: err.Error()}}
+		ok = *indexName == "" && *docID < 0
+		return
+	}
+	var pls []payload
+	if ent.Type == walpb.EntryType_EntryBatch {
+		batch := &walpb.Batch{}
+		if err = batch.Unmarshal(data); err != nil {
+			pls = []payload{{Type: r.Entry, Error: err.Error()}}
+		} else {
+			for i := range batch.Entries {
+				pls = append(pls, decodePayload(batch.Entries[i].Type, batch.Entries[i].Data))
+			}
+		}
+	} else {
+		pls = []payload{decodePayload(ent.Type, data)}
+	}
+	for _, pl := range pls {
+		if matchPayload(pl) {
+			r.Payloads = append(r.Payloads, pl)
+		}
+	}
+	ok = len(r.Payloads) != 0
+	return
+}
+
+func decodePayload(typ walpb.EntryType, data []byte) (pl payload) {
+	var err error
+	pl.Type = typ.String()
+	switch typ {
+	case walpb.EntryType_EntryInsert, walpb.EntryType_EntryUpdate, walpb.EntryType_EntryAlterIndex, walpb.EntryType_EntryCreateIndex:
+		pl.Doc = &cql.DocumentWithIdx{}
+		err = pl.Doc.Unmarshal(data)
+	case walpb.EntryType_EntryDelete:
+		pl.Del = &cql.DocumentDel{}
+		err = pl.Del.Unmarshal(data)
+	case walpb.EntryType_EntryDestroyIndex:
+		pl.Index = string(data)
+	default:
+		err = errors.Errorf("unknown entry type %v", typ)
+	}
+	if err != nil {
+		pl.Error = err.Error()
+	}
+	return
+}
+
+//matchPayload tells whether pl passes the index name and docID filters.
+//Schema operations don't carry a docID, so they never match a docID filter.
+func matchPayload(pl payload) bool {
+	var name string
+	var id int64 = -1
+	switch {
+	case pl.Del != nil:
+		name, id = pl.Del.Index, int64(pl.Del.DocID)
+	case pl.Doc != nil:
+		name = pl.Doc.Index
+		if pl.Type == walpb.EntryType_EntryInsert.String() || pl.Type == walpb.EntryType_EntryUpdate.String() {
+			id = int64(pl.Doc.Doc.DocID)
+		}
+	default:
+		name = pl.Index
+	}
+	if *indexName != "" && name != *indexName {
+		return false
+	}
+	if *docID >= 0 && id != *docID {
+		return false
+	}
+	return true
+}
+
+func printRecord(r record) {
+	if r.Entry == "" {
+		fmt.Printf("%s crc=%08x\n", r.Type, r.Crc)
+		return
+	}
+	fmt.Printf("%s crc=%08x term=%d index=%d type=%s\n", r.Type, r.Crc, r.Term, r.Index, r.Entry)
+	for _, pl := range r.Payloads {
+		switch {
+		case pl.Error != "":
+			fmt.Printf("\t%s error: %s\n", pl.Type, pl.Error)
+		case pl.Doc != nil:
+			fmt.Printf("\t%s %v\n", pl.Type, pl.Doc)
+		case pl.Del != nil:
+			fmt.Printf("\t%s %v\n", pl.Type, pl.Del)
+		default:
+			fmt.Printf("\t%s index:%q\n", pl.Type, pl.Index)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package coordinator
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/deepfabric/indexer"
+	"github.com/deepfabric/indexer/cql"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	//DefaultTimeout is the default value for Options.Timeout.
+	DefaultTimeout = 5 * time.Second
+)
+
+var (
+	ErrNoNode        = errors.New("no node given")
+	ErrAllNodesFail  = errors.New("all nodes failed")
+	ErrPartialResult = errors.New("some nodes failed")
+)
+
+//Options controls the behavior of Coordinator.
+type Options struct {
+	//Timeout is the max time a node is given to answer a query. 0 means DefaultTimeout.
+	Timeout time.Duration
+	//AllowPartial makes Select succeed with the results of the available nodes if some, but not all, nodes fail.
+	AllowPartial bool
+}
+
+//Coordinator fans queries out to a set of indexer nodes, each of which owns some docID fragment ranges, and merges the results.
+type Coordinator struct {
+	nodes     []string
+	transport Transport
+	opts      Options
+}
+
+//Result is the merged result of a query.
+type Result struct {
+	*indexer.QueryResult
+	Failed map[string]error //map node to its error. nil if all nodes succeeded.
+}
+
+//NewCoordinator creates a Coordinator of the given nodes. The meaning of a node address is up to transport.
+func NewCoordinator(nodes []string, transport Transport, opts Options) (c *Coordinator, err error) {
+	if len(nodes) == 0 {
+		err = errors.Wrap(ErrNoNode, "")
+		return
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultTimeout
+	}
+	c = &Coordinator{
+		nodes:     append([]string(nil), nodes...),
+		transport: transport,
+		opts:      opts,
+	}
+	return
+}
+
+//Nodes returns the node addresses.
+func (c *Coordinator) Nodes() []string {
+	return append([]string(nil), c.nodes...)
+}
+
+type reply struct {
+	node string
+	qr   *indexer.QueryResult
+	err  error
+}
+
+//Select executes q on all nodes concurrently, and merges the bitmaps and the top-N ordered results.
+//A node which doesn't answer within Options.Timeout is treated as failed.
+//If all nodes fail, err is ErrAllNodesFail. If some nodes fail and Options.AllowPartial is false, err is ErrPartialResult.
+//In both cases res is still returned with the failures, and the merged results of the other nodes.
+func (c *Coordinator) Select(ctx context.Context, q *cql.CqlSelect) (res *Result, err error) {
+	replies := make(chan reply, len(c.nodes))
+	for _, node := range c.nodes {
+		go func(node string) {
+			nctx, cancel := context.WithTimeout(ctx, c.opts.Timeout)
+			defer cancel()
+			qr, err := c.transport.Select(nctx, node, q)
+			replies <- reply{node: node, qr: qr, err: err}
+		}(node)
+	}
+
+	res = &Result{QueryResult: indexer.NewQueryResult(q.Limit)}
+	for range c.nodes {
+		r := <-replies
+		if r.err != nil {
+			log.Warnf("coordinator node %v failed to select %+v: %+v", r.node, q, r.err)
+			if res.Failed == nil {
+				res.Failed = make(map[string]error)
+			}
+			res.Failed[r.node] = r.err
+			continue
+		}
+		res.Merge(r.qr)
+	}
+	if len(res.Failed) == 0 {
+		return
+	}
+	failed := make([]string, 0, len(res.Failed))
+	for node := range res.Failed {
+		failed = append(failed, node)
+	}
+	sort.Strings(failed)
+	if len(res.Failed) == len(c.nodes) {
+		err = errors.Wrapf(ErrAllNodesFail, "%v", failed)
+	} else if !c.opts.AllowPartial {
+		err = errors.Wrapf(ErrPartialResult, "%v", failed)
+	}
+	return
+}
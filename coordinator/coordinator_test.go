@@ -0,0 +1,186 @@
+package coordinator
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/deepfabric/indexer"
+	"github.com/deepfabric/indexer/cql"
+	"github.com/pilosa/pilosa"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	NumNodes    = 3
+	DocsPerNode = 100
+)
+
+func newDocProt() *cql.DocumentWithIdx {
+	return &cql.DocumentWithIdx{
+		Doc: cql.Document{
+			DocID: 0,
+			UintProps: []*cql.UintProp{
+				&cql.UintProp{
+					Name:   "price",
+					ValLen: 4,
+					Val:    0,
+				},
+			},
+			StrProps: []*cql.StrProp{
+				&cql.StrProp{
+					Name: "description",
+					Val:  "",
+				},
+			},
+		},
+		Index: "orders",
+	}
+}
+
+//newNodes creates NumNodes indexers, node i owns docIDs of slice i.
+func newNodes(t *testing.T) (irs map[string]*indexer.Indexer) {
+	irs = make(map[string]*indexer.Indexer)
+	for n := 0; n < NumNodes; n++ {
+		ir, err := indexer.NewIndexer(fmt.Sprintf("/tmp/coordinator_test/node%d", n), true, false)
+		require.NoError(t, err)
+		err = ir.CreateIndex(newDocProt())
+		require.NoError(t, err)
+		for i := 0; i < DocsPerNode; i++ {
+			doc := newDocProt()
+			doc.Doc.DocID = uint64(n)*pilosa.SliceWidth + uint64(i)
+			doc.Doc.UintProps[0].Val = uint64(n*DocsPerNode + i)
+			doc.Doc.StrProps[0].Val = fmt.Sprintf("node%d doc%d", n, i%10)
+			err = ir.Insert(doc)
+			require.NoError(t, err)
+		}
+		irs[fmt.Sprintf("node%d", n)] = ir
+	}
+	return
+}
+
+func closeNodes(t *testing.T, irs map[string]*indexer.Indexer) {
+	for _, ir := range irs {
+		err := ir.Close()
+		require.NoError(t, err)
+	}
+}
+
+func newQuery() *cql.CqlSelect {
+	return &cql.CqlSelect{
+		Index: "orders",
+		UintPreds: map[string]cql.UintPred{
+			"price": cql.UintPred{
+				Name: "price",
+				Low:  50,
+				High: 250,
+			},
+		},
+		StrPreds: map[string]cql.StrPred{
+			"description": cql.StrPred{
+				Name:     "description",
+				ContWord: "doc3",
+			},
+		},
+		Limit: 5,
+	}
+}
+
+//hangTransport never answers for the nodes in down.
+type hangTransport struct {
+	Transport
+	down map[string]bool
+}
+
+func (t *hangTransport) Select(ctx context.Context, node string, q *cql.CqlSelect) (qr *indexer.QueryResult, err error) {
+	if t.down[node] {
+		<-ctx.Done()
+		err = ctx.Err()
+		return
+	}
+	return t.Transport.Select(ctx, node, q)
+}
+
+func TestCoordinatorSelect(t *testing.T) {
+	irs := newNodes(t)
+	defer closeNodes(t, irs)
+	var nodes []string
+	for node := range irs {
+		nodes = append(nodes, node)
+	}
+
+	//TESTCASE: bitmaps of all nodes are merged
+	c, err := NewCoordinator(nodes, NewLocalTransport(irs), Options{})
+	require.NoError(t, err)
+	q := newQuery()
+	res, err := c.Select(context.Background(), q)
+	require.NoError(t, err)
+	require.Nil(t, res.Failed)
+	require.Equal(t, uint64(20), res.Bm.Count())
+
+	//TESTCASE: top-N of all nodes are merged
+	q.OrderBy = "price"
+	res, err = c.Select(context.Background(), q)
+	require.NoError(t, err)
+	items := res.Oa.Finalize()
+	require.Equal(t, q.Limit, len(items))
+
+	//TESTCASE: unknown index fails on all nodes
+	q.Index = "users"
+	_, err = c.Select(context.Background(), q)
+	require.Equal(t, ErrAllNodesFail, errors.Cause(err))
+
+	//TESTCASE: a node timeout fails the query unless partial results are allowed
+	trans := &hangTransport{Transport: NewLocalTransport(irs), down: map[string]bool{"node1": true}}
+	c, err = NewCoordinator(nodes, trans, Options{Timeout: 100 * time.Millisecond})
+	require.NoError(t, err)
+	q = newQuery()
+	res, err = c.Select(context.Background(), q)
+	require.Equal(t, ErrPartialResult, errors.Cause(err))
+	require.Equal(t, 1, len(res.Failed))
+	require.Equal(t, context.DeadlineExceeded, errors.Cause(res.Failed["node1"]))
+
+	c, err = NewCoordinator(nodes, trans, Options{Timeout: 100 * time.Millisecond, AllowPartial: true})
+	require.NoError(t, err)
+	res, err = c.Select(context.Background(), q)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Failed))
+	require.Equal(t, uint64(10), res.Bm.Count())
+}
+
+func TestCoordinatorHTTP(t *testing.T) {
+	irs := newNodes(t)
+	defer closeNodes(t, irs)
+	var nodes []string
+	for _, ir := range irs {
+		srv := httptest.NewServer(NewHandler(ir))
+		defer srv.Close()
+		nodes = append(nodes, srv.URL)
+	}
+
+	//TESTCASE: results over HTTP are the same as the local ones
+	c, err := NewCoordinator(nodes, &HTTPTransport{}, Options{})
+	require.NoError(t, err)
+	q := newQuery()
+	res, err := c.Select(context.Background(), q)
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), res.Bm.Count())
+
+	local, err := NewCoordinator([]string{"node0", "node1", "node2"}, NewLocalTransport(irs), Options{})
+	require.NoError(t, err)
+	q.OrderBy = "price"
+	res, err = c.Select(context.Background(), q)
+	require.NoError(t, err)
+	res2, err := local.Select(context.Background(), q)
+	require.NoError(t, err)
+	require.Equal(t, res2.Oa.Finalize(), res.Oa.Finalize())
+
+	//TESTCASE: errors of nodes are returned
+	q.Index = "users"
+	res, err = c.Select(context.Background(), q)
+	require.Equal(t, ErrAllNodesFail, errors.Cause(err))
+	require.Equal(t, NumNodes, len(res.Failed))
+}
@@ -0,0 +1,214 @@
+package coordinator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/deepfabric/bkdtree"
+	"github.com/deepfabric/indexer"
+	"github.com/deepfabric/indexer/cql"
+	"github.com/pilosa/pilosa"
+	"github.com/pkg/errors"
+)
+
+const (
+	//SelectPath is the HTTP path served by Handler for selects.
+	SelectPath = "/select"
+)
+
+var (
+	ErrUnknownNode = errors.New("unknown node")
+)
+
+//Transport sends a query to a node. Implementations shall return once ctx is done.
+type Transport interface {
+	Select(ctx context.Context, node string, q *cql.CqlSelect) (qr *indexer.QueryResult, err error)
+}
+
+//Point is an item of an ordered result.
+type Point struct {
+	Vals  []uint64 `json:"vals"`
+	DocID uint64   `json:"docID"`
+}
+
+//SelectResponse is the wire form of an indexer.QueryResult.
+type SelectResponse struct {
+	Bits   []uint64                       `json:"bits,omitempty"`   //used when no OrderBy given
+	Points []Point                        `json:"points,omitempty"` //used when OrderBy given
+	Hl     map[uint64]map[string][]string `json:"hl,omitempty"`
+	Error  string                         `json:"error,omitempty"`
+}
+
+//NewSelectResponse converts qr to its wire form. It finalizes qr.Oa, so qr shall not be used afterwards.
+func NewSelectResponse(qr *indexer.QueryResult) (resp *SelectResponse) {
+	resp = &SelectResponse{
+		Bits: qr.Bm.Bits(),
+		Hl:   qr.Hl,
+	}
+	for _, item := range qr.Oa.Finalize() {
+		point := item.(bkdtree.Point)
+		resp.Points = append(resp.Points, Point{Vals: point.Vals, DocID: point.UserData})
+	}
+	return
+}
+
+//QueryResult converts resp back to an indexer.QueryResult. limit shall be the Limit of the query.
+func (resp *SelectResponse) QueryResult(limit int) (qr *indexer.QueryResult) {
+	qr = indexer.NewQueryResult(limit)
+	qr.Bm = pilosa.NewBitmap(resp.Bits...)
+	qr.Hl = resp.Hl
+	for _, point := range resp.Points {
+		qr.Oa.Put(bkdtree.Point{Vals: point.Vals, UserData: point.DocID})
+	}
+	return
+}
+
+//LocalTransport queries Indexers in the same process. It's mainly used for tests.
+type LocalTransport struct {
+	mu    sync.RWMutex
+	nodes map[string]*indexer.Indexer
+}
+
+//NewLocalTransport creates a LocalTransport of the given nodes.
+func NewLocalTransport(nodes map[string]*indexer.Indexer) (t *LocalTransport) {
+	t = &LocalTransport{nodes: make(map[string]*indexer.Indexer, len(nodes))}
+	for node, ir := range nodes {
+		t.nodes[node] = ir
+	}
+	return
+}
+
+//SetNode adds or replaces a node. A nil ir removes the node.
+func (t *LocalTransport) SetNode(node string, ir *indexer.Indexer) {
+	t.mu.Lock()
+	if ir == nil {
+		delete(t.nodes, node)
+	} else {
+		t.nodes[node] = ir
+	}
+	t.mu.Unlock()
+}
+
+//Select implements Transport.
+func (t *LocalTransport) Select(ctx context.Context, node string, q *cql.CqlSelect) (qr *indexer.QueryResult, err error) {
+	t.mu.RLock()
+	ir, found := t.nodes[node]
+	t.mu.RUnlock()
+	if !found {
+		err = errors.Wrap(ErrUnknownNode, node)
+		return
+	}
+	replies := make(chan reply, 1)
+	go func() {
+		qr, err := ir.Select(q)
+		replies <- reply{node: node, qr: qr, err: err}
+	}()
+	select {
+	case r := <-replies:
+		qr, err = r.qr, r.err
+	case <-ctx.Done():
+		err = errors.Wrap(ctx.Err(), node)
+	}
+	return
+}
+
+//HTTPTransport queries nodes served by Handler. A node is an HTTP base URL, or a host:port which implies http.
+type HTTPTransport struct {
+	Client *http.Client //http.DefaultClient if nil
+}
+
+//Select implements Transport.
+func (t *HTTPTransport) Select(ctx context.Context, node string, q *cql.CqlSelect) (qr *indexer.QueryResult, err error) {
+	var body []byte
+	if body, err = json.Marshal(q); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	url := node
+	if !strings.Contains(url, "://") {
+		url = "http://" + url
+	}
+	url = strings.TrimSuffix(url, "/") + SelectPath
+	var req *http.Request
+	if req, err = http.NewRequest(http.MethodPost, url, bytes.NewReader(body)); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	client := t.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	var httpResp *http.Response
+	if httpResp, err = client.Do(req.WithContext(ctx)); err != nil {
+		err = errors.Wrap(err, node)
+		return
+	}
+	defer func() {
+		io.Copy(ioutil.Discard, httpResp.Body)
+		httpResp.Body.Close()
+	}()
+	var resp SelectResponse
+	if err = json.NewDecoder(httpResp.Body).Decode(&resp); err != nil {
+		err = errors.Wrapf(err, "node %v, status %v", node, httpResp.Status)
+		return
+	}
+	if resp.Error != "" || httpResp.StatusCode != http.StatusOK {
+		err = errors.Errorf("node %v, status %v: %v", node, httpResp.Status, resp.Error)
+		return
+	}
+	qr = resp.QueryResult(q.Limit)
+	return
+}
+
+//Handler serves selects of HTTPTransport with an Indexer.
+type Handler struct {
+	ir *indexer.Indexer
+}
+
+//NewHandler creates a Handler of ir.
+func NewHandler(ir *indexer.Indexer) *Handler {
+	return &Handler{ir: ir}
+}
+
+//ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != SelectPath {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		writeResponse(w, http.StatusMethodNotAllowed, &SelectResponse{Error: "method not allowed"})
+		return
+	}
+	var q cql.CqlSelect
+	if err := json.NewDecoder(r.Body).Decode(&q); err != nil {
+		writeResponse(w, http.StatusBadRequest, &SelectResponse{Error: err.Error()})
+		return
+	}
+	qr, err := h.ir.Select(&q)
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch errors.Cause(err) {
+		case indexer.ErrIdxNotExist:
+			status = http.StatusNotFound
+		case indexer.ErrUnknownProp:
+			status = http.StatusBadRequest
+		}
+		writeResponse(w, status, &SelectResponse{Error: err.Error()})
+		return
+	}
+	writeResponse(w, http.StatusOK, NewSelectResponse(qr))
+}
+
+func writeResponse(w http.ResponseWriter, status int, resp *SelectResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(resp)
+}
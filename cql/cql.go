@@ -1,6 +1,7 @@
 package cql
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"strconv"
@@ -38,6 +39,11 @@ type StrPred struct {
 	ContWord string
 }
 
+type ExistPred struct {
+	Name   string
+	Exists bool //true for EXISTS, false for IS NULL
+}
+
 type CqlCreate struct {
 	DocumentWithIdx
 }
@@ -46,6 +52,13 @@ type CqlDestroy struct {
 	Index string
 }
 
+type CqlAlter struct {
+	Index     string
+	AddProps  Document //properties to add. Their values are ignored.
+	Defaults  Document //default values of the properties to add
+	DropProps []string //names of properties to drop
+}
+
 type CqlInsert struct {
 	DocumentWithIdx
 }
@@ -55,12 +68,14 @@ type CqlDel struct {
 }
 
 type CqlSelect struct {
-	Index     string
-	UintPreds map[string]UintPred
-	EnumPreds map[string]EnumPred
-	StrPreds  map[string]StrPred
-	OrderBy   string
-	Limit     int
+	Index      string
+	UintPreds  map[string]UintPred
+	EnumPreds  map[string]EnumPred
+	StrPreds   map[string]StrPred
+	ExistPreds map[string]ExistPred
+	OrderBy    string
+	Limit      int
+	Highlight  bool //whether to return highlighted fragments of StrPreds. Requires the index stores documents.
 }
 
 type VerboseErrorListener struct {
@@ -105,6 +120,8 @@ func (v *myCqlVisitor) VisitCql(ctx *parser.CqlContext) (err interface{}) {
 		err = v.VisitCreate(create.(*parser.CreateContext))
 	} else if destroy := ctx.Destroy(); destroy != nil {
 		err = v.VisitDestroy(destroy.(*parser.DestroyContext))
+	} else if alter := ctx.Alter(); alter != nil {
+		err = v.VisitAlter(alter.(*parser.AlterContext))
 	} else if ins := ctx.Insert(); ins != nil {
 		err = v.VisitInsert(ins.(*parser.InsertContext))
 	} else if del := ctx.Del(); del != nil {
@@ -121,28 +138,78 @@ func (v *myCqlVisitor) VisitCql(ctx *parser.CqlContext) (err interface{}) {
 func (v *myCqlVisitor) VisitCreate(ctx *parser.CreateContext) (err interface{}) {
 	q := &CqlCreate{}
 	q.Index = ctx.IndexName().GetText()
+	q.StoreDoc = ctx.K_STORE() != nil
 	for _, popDef := range ctx.AllUintPropDef() {
-		if err = v.VisitUintPropDef(popDef.(*parser.UintPropDefContext)); err != nil {
+		if err = v.addUintPropDef(popDef.(*parser.UintPropDefContext), &q.Doc, &q.Defaults); err != nil {
 			return
 		}
-		q.Doc.UintProps = append(q.Doc.UintProps, v.res.(*UintProp))
 	}
 	for _, popDef := range ctx.AllEnumPropDef() {
-		if err = v.VisitEnumPropDef(popDef.(*parser.EnumPropDefContext)); err != nil {
+		if err = v.addEnumPropDef(popDef.(*parser.EnumPropDefContext), &q.Doc, &q.Defaults); err != nil {
 			return
 		}
-		q.Doc.EnumProps = append(q.Doc.EnumProps, v.res.(*EnumProp))
 	}
 	for _, popDef := range ctx.AllStrPropDef() {
-		if err = v.VisitStrPropDef(popDef.(*parser.StrPropDefContext)); err != nil {
+		if err = v.addStrPropDef(popDef.(*parser.StrPropDefContext), &q.Doc, &q.Defaults); err != nil {
 			return
 		}
-		q.Doc.StrProps = append(q.Doc.StrProps, v.res.(*StrProp))
 	}
 	v.res = q
 	return
 }
 
+//addUintPropDef appends the defined property to doc, and its default value to defaults if there is.
+func (v *myCqlVisitor) addUintPropDef(ctx *parser.UintPropDefContext, doc, defaults *Document) (err interface{}) {
+	if err = v.VisitUintPropDef(ctx); err != nil {
+		return
+	}
+	pop := v.res.(*UintProp)
+	doc.UintProps = append(doc.UintProps, pop)
+	if valCtx := ctx.Value(); valCtx != nil {
+		def := *pop
+		if def.Val, err = ParseUintProp(pop, valCtx.GetText()); err != nil {
+			return
+		}
+		defaults.UintProps = append(defaults.UintProps, &def)
+	}
+	return
+}
+
+//addEnumPropDef appends the defined property to doc, and its default value to defaults if there is.
+func (v *myCqlVisitor) addEnumPropDef(ctx *parser.EnumPropDefContext, doc, defaults *Document) (err interface{}) {
+	if err = v.VisitEnumPropDef(ctx); err != nil {
+		return
+	}
+	pop := v.res.(*EnumProp)
+	doc.EnumProps = append(doc.EnumProps, pop)
+	if intCtx := ctx.INT(); intCtx != nil {
+		var tmpInt int
+		if tmpInt, err = strconv.Atoi(intCtx.GetText()); err != nil {
+			err = errors.Wrap(err.(error), "")
+			return
+		}
+		def := *pop
+		def.Val = uint64(tmpInt)
+		defaults.EnumProps = append(defaults.EnumProps, &def)
+	}
+	return
+}
+
+//addStrPropDef appends the defined property to doc, and its default value to defaults if there is.
+func (v *myCqlVisitor) addStrPropDef(ctx *parser.StrPropDefContext, doc, defaults *Document) (err interface{}) {
+	if err = v.VisitStrPropDef(ctx); err != nil {
+		return
+	}
+	pop := v.res.(*StrProp)
+	doc.StrProps = append(doc.StrProps, pop)
+	if strCtx := ctx.STRING(); strCtx != nil {
+		def := *pop
+		def.Val = stripQuote(strCtx.GetText())
+		defaults.StrProps = append(defaults.StrProps, &def)
+	}
+	return
+}
+
 func (v *myCqlVisitor) VisitUintPropDef(ctx *parser.UintPropDefContext) (err interface{}) {
 	var pop UintProp
 	pop.Name = ctx.Property().GetText()
@@ -189,6 +256,35 @@ func (v *myCqlVisitor) VisitDestroy(ctx *parser.DestroyContext) (err interface{}
 	return
 }
 
+func (v *myCqlVisitor) VisitAlter(ctx *parser.AlterContext) (err interface{}) {
+	q := &CqlAlter{}
+	q.Index = ctx.IndexName().GetText()
+	for _, addCtx := range ctx.AllAlterAdd() {
+		add := addCtx.(*parser.AlterAddContext)
+		if popDef := add.UintPropDef(); popDef != nil {
+			if err = v.addUintPropDef(popDef.(*parser.UintPropDefContext), &q.AddProps, &q.Defaults); err != nil {
+				return
+			}
+		} else if popDef := add.EnumPropDef(); popDef != nil {
+			if err = v.addEnumPropDef(popDef.(*parser.EnumPropDefContext), &q.AddProps, &q.Defaults); err != nil {
+				return
+			}
+		} else if popDef := add.StrPropDef(); popDef != nil {
+			if err = v.addStrPropDef(popDef.(*parser.StrPropDefContext), &q.AddProps, &q.Defaults); err != nil {
+				return
+			}
+		} else {
+			err = errors.Errorf("unsupported subrule of alterAdd")
+			return
+		}
+	}
+	for _, dropCtx := range ctx.AllAlterDrop() {
+		q.DropProps = append(q.DropProps, dropCtx.(*parser.AlterDropContext).Property().GetText())
+	}
+	v.res = q
+	return
+}
+
 func (v *myCqlVisitor) VisitInsert(ctx *parser.InsertContext) (err interface{}) {
 	if err = v.VisitDocument(ctx.Document().(*parser.DocumentContext)); err != nil {
 		return
@@ -209,19 +305,53 @@ func (v *myCqlVisitor) VisitDel(ctx *parser.DelContext) (err interface{}) {
 	return
 }
 
+//VisitDocument accepts either positional values of all properties in the schema order,
+//or named values of some properties. Properties absent from the latter are left unset.
 func (v *myCqlVisitor) VisitDocument(ctx *parser.DocumentContext) (err interface{}) {
 	index := ctx.IndexName().GetText()
 	docProt, ok := v.docProts[index]
-	want := len(docProt.UintProps) + len(docProt.EnumProps) + len(docProt.StrProps)
 	if !ok {
 		err = errors.Errorf("failed to find the definion of index %s\n", index)
 		return
-	} else if len(ctx.AllValue()) != want {
-		err = errors.Errorf("invalid number of values, is %d, want %d\n", len(ctx.AllValue()), want)
-		return
 	}
+	valSs := make(map[string]string)
+	if namedVals := ctx.AllNamedValue(); len(namedVals) != 0 {
+		for _, nvCtx := range namedVals {
+			nv := nvCtx.(*parser.NamedValueContext)
+			name := nv.Property().GetText()
+			if !hasProp(docProt, name) {
+				err = errors.Errorf("cannot find property %s in index %s", name, index)
+				return
+			} else if _, ok = valSs[name]; ok {
+				err = errors.Errorf("invalid document due to multiple values of property %s", name)
+				return
+			}
+			valSs[name] = nv.Value().GetText()
+		}
+	} else {
+		vals := ctx.AllValue()
+		want := len(docProt.UintProps) + len(docProt.EnumProps) + len(docProt.StrProps)
+		if len(vals) != want {
+			err = errors.Errorf("invalid number of values, is %d, want %d\n", len(vals), want)
+			return
+		}
+		i := 0
+		for _, uintProp := range docProt.UintProps {
+			valSs[uintProp.Name] = vals[i].GetText()
+			i++
+		}
+		for _, enumProp := range docProt.EnumProps {
+			valSs[enumProp.Name] = vals[i].GetText()
+			i++
+		}
+		for _, strProp := range docProt.StrProps {
+			valSs[strProp.Name] = vals[i].GetText()
+			i++
+		}
+	}
+
 	doc := &DocumentWithIdx{}
-	doc.Index = ctx.IndexName().GetText()
+	doc.Index = index
 	var tmpU64 uint64
 	var tmpInt int
 	tmpU64, err = strconv.ParseUint(ctx.DocId().GetText(), 10, 64)
@@ -231,41 +361,72 @@ func (v *myCqlVisitor) VisitDocument(ctx *parser.DocumentContext) (err interface
 	}
 	doc.Doc.DocID = tmpU64
 
-	vals := ctx.AllValue()
-	for i := 0; i < len(docProt.UintProps); i++ {
-		if tmpU64, err = ParseUintProp(docProt.UintProps[i], vals[i].GetText()); err != nil {
+	//copy properties of docProt since their values are modified
+	for _, uintProt := range docProt.UintProps {
+		valS, found := valSs[uintProt.Name]
+		if !found {
+			continue
+		}
+		uintProp := *uintProt
+		if uintProp.Val, err = ParseUintProp(uintProt, valS); err != nil {
 			return
 		}
-		uintProp := docProt.UintProps[i]
-		uintProp.Val = tmpU64
-		doc.Doc.UintProps = append(doc.Doc.UintProps, uintProp)
+		doc.Doc.UintProps = append(doc.Doc.UintProps, &uintProp)
 	}
-	for i := 0; i < len(docProt.EnumProps); i++ {
-		tmpInt, err = strconv.Atoi(vals[i+len(docProt.UintProps)].GetText())
-		if err != nil {
+	for _, enumProt := range docProt.EnumProps {
+		valS, found := valSs[enumProt.Name]
+		if !found {
+			continue
+		}
+		if tmpInt, err = strconv.Atoi(valS); err != nil {
 			err = errors.Wrap(err.(error), "")
 			return
 		}
-		enumProp := docProt.EnumProps[i]
+		enumProp := *enumProt
 		enumProp.Val = uint64(tmpInt)
-		doc.Doc.EnumProps = append(doc.Doc.EnumProps, enumProp)
+		doc.Doc.EnumProps = append(doc.Doc.EnumProps, &enumProp)
 	}
-	for i := 0; i < len(docProt.StrProps); i++ {
-		strProp := docProt.StrProps[i]
-		strProp.Val = vals[i+len(docProt.UintProps)+len(docProt.EnumProps)].GetText()
-		doc.Doc.StrProps = append(doc.Doc.StrProps, strProp)
+	for _, strProt := range docProt.StrProps {
+		valS, found := valSs[strProt.Name]
+		if !found {
+			continue
+		}
+		strProp := *strProt
+		strProp.Val = stripQuote(valS)
+		doc.Doc.StrProps = append(doc.Doc.StrProps, &strProp)
 	}
 	v.res = doc
 	return
 }
 
+func hasProp(docProt *Document, name string) bool {
+	for _, uintProp := range docProt.UintProps {
+		if uintProp.Name == name {
+			return true
+		}
+	}
+	for _, enumProp := range docProt.EnumProps {
+		if enumProp.Name == name {
+			return true
+		}
+	}
+	for _, strProp := range docProt.StrProps {
+		if strProp.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 func (v *myCqlVisitor) VisitQuery(ctx *parser.QueryContext) (err interface{}) {
 	v.index = ctx.IndexName().GetText()
 	q := &CqlSelect{
-		Index:     ctx.IndexName().GetText(),
-		UintPreds: make(map[string]UintPred),
-		EnumPreds: make(map[string]EnumPred),
-		StrPreds:  make(map[string]StrPred),
+		Index:      ctx.IndexName().GetText(),
+		UintPreds:  make(map[string]UintPred),
+		EnumPreds:  make(map[string]EnumPred),
+		StrPreds:   make(map[string]StrPred),
+		ExistPreds: make(map[string]ExistPred),
+		Highlight:  ctx.K_HIGHLIGHT() != nil,
 	}
 
 	for i, predCtx := range ctx.AllUintPred() {
@@ -326,6 +487,17 @@ func (v *myCqlVisitor) VisitQuery(ctx *parser.QueryContext) (err interface{}) {
 		}
 		q.StrPreds[strPred.Name] = strPred
 	}
+	for _, predCtx := range ctx.AllExistPred() {
+		if err = v.VisitExistPred(predCtx.(*parser.ExistPredContext)); err != nil {
+			return
+		}
+		existPred := *(v.res.(*ExistPred))
+		if _, ok := q.ExistPreds[existPred.Name]; ok {
+			err = errors.Errorf("invalid query due to multiple ExistPred of property %s", existPred.Name)
+			return
+		}
+		q.ExistPreds[existPred.Name] = existPred
+	}
 	v.res = q
 	return
 }
@@ -346,7 +518,12 @@ func maxU64(a, b uint64) uint64 {
 }
 
 //https://stackoverflow.com/questions/44222554/how-to-remove-quotes-from-around-a-string-in-golang
+//stripQuote returns the value of a STRING literal. The escape sequences of STRING are the ones of JSON strings.
 func stripQuote(s string) string {
+	var val string
+	if err := json.Unmarshal([]byte(s), &val); err == nil {
+		return val
+	}
 	if len(s) > 0 && s[0] == '"' {
 		s = s[1:]
 	}
@@ -473,6 +650,24 @@ func (v *myCqlVisitor) VisitStrPred(ctx *parser.StrPredContext) (err interface{}
 	return
 }
 
+func (v *myCqlVisitor) VisitExistPred(ctx *parser.ExistPredContext) (err interface{}) {
+	pred := &ExistPred{}
+	pred.Name = ctx.Property().GetText()
+	var docProt *Document
+	var ok bool
+	if docProt, ok = v.docProts[v.index]; !ok {
+		err = errors.Errorf("cannot find docProt for index %s", v.index)
+		return
+	}
+	if !hasProp(docProt, pred.Name) {
+		err = errors.Errorf("cannot find ExistPred %s in index %s", pred.Name, v.index)
+		return
+	}
+	pred.Exists = ctx.K_EXISTS() != nil
+	v.res = pred
+	return
+}
+
 type orderLimit struct {
 	order string
 	limit int
@@ -529,6 +724,18 @@ func Float64ToSortableUint64(valS string) (val uint64, err error) {
 	return
 }
 
+//SortableUint64ToFloat32 is the inverse of Float32ToSortableUint64.
+func SortableUint64ToFloat32(val uint64) float32 {
+	int1 := int32(uint32(val) ^ 0x80000000)
+	return math.Float32frombits(uint32(int1 ^ ((int1 >> 31) & 0x7fffffff)))
+}
+
+//SortableUint64ToFloat64 is the inverse of Float64ToSortableUint64.
+func SortableUint64ToFloat64(val uint64) float64 {
+	int1 := int64(val ^ 0x8000000000000000)
+	return math.Float64frombits(uint64(int1 ^ ((int1 >> 63) & 0x7fffffffffffffff)))
+}
+
 //ParseUintProp parses valS
 func ParseUintProp(uintProp *UintProp, valS string) (val uint64, err error) {
 	if uintProp.IsFloat {
@@ -553,7 +760,18 @@ func ParseUintProp(uintProp *UintProp, valS string) (val uint64, err error) {
 	return
 }
 
-//ParseCql parse CQL. res type is one of CqlCreate/CqlDestroy/CqlInsert/CqlDel/CqlQuery.
+//FormatUintProp is the inverse of ParseUintProp.
+func FormatUintProp(uintProp *UintProp, val uint64) string {
+	if uintProp.IsFloat {
+		if uintProp.ValLen == 4 {
+			return strconv.FormatFloat(float64(SortableUint64ToFloat32(val)), 'g', -1, 32)
+		}
+		return strconv.FormatFloat(SortableUint64ToFloat64(val), 'g', -1, 64)
+	}
+	return strconv.FormatUint(val, 10)
+}
+
+//ParseCql parse CQL. res type is one of CqlCreate/CqlDestroy/CqlAlter/CqlInsert/CqlDel/CqlQuery.
 func ParseCql(cql string, docProts map[string]*Document) (res interface{}, err error) {
 	input := antlr.NewInputStream(cql)
 	lexer := parser.NewCQLLexer(input)
@@ -17,12 +17,17 @@ func TestParseCql(t *testing.T) {
 		"IDX.CREATE orders SCHEMA object UINT64 price UINT32 number UINT32 date UINT64 type ENUM",
 		"IDX.CREATE orders SCHEMA object UINT64 price UINT32 number UINT32 date UINT64 desc STRING",
 		"IDX.CREATE orders SCHEMA object UINT64 price UINT32 number UINT32 date UINT64 type ENUM desc STRING",
+		"IDX.CREATE orders SCHEMA object UINT64 price UINT32 DEFAULT 0 number UINT32 date UINT64 type ENUM DEFAULT 1 desc STRING DEFAULT \"\"",
+		"IDX.CREATE orders STORE SCHEMA object UINT64 price UINT32 number UINT32 date UINT64 type ENUM desc STRING",
 		"IDX.INSERT orders 615 11 22 33 44 3 \"description\"",
+		"IDX.INSERT orders 616 price=22 desc=\"description\"",
 		"IDX.DEL orders 615 11 22 33 44 3 \"description\"",
 		"IDX.SELECT orders WHERE price>=30 price<40 date<2017 type IN [1,3] desc CONTAINS \"pen\" ORDERBY date",
 		"IDX.SELECT orders WHERE price>=30 price<=40 date<2017 type IN [1,3] ORDERBY date LIMIT 30",
 		"IDX.SELECT orders WHERE price>=30 price<=40 type IN [1,3]",
+		"IDX.SELECT orders WHERE price>=30 desc CONTAINS \"pen\" ORDERBY price LIMIT 10 HIGHLIGHT",
 		"QUERY orders WHERE price>=30 price<=40 type IN [1,3]",
+		"IDX.ALTER orders ADD weight FLOAT32 ADD note STRING DROP number",
 		"IDX.DESTROY orders",
 	}
 	docProts := make(map[string]*Document)
@@ -38,6 +43,8 @@ func TestParseCql(t *testing.T) {
 		case *CqlDestroy:
 			fmt.Printf("Destroy index %s\n", r.Index)
 			delete(docProts, r.Index)
+		case *CqlAlter:
+			fmt.Printf("Alter index %v\n", r)
 		case *CqlInsert:
 			fmt.Printf("Insert %v\n", r)
 		case *CqlDel:
@@ -51,6 +58,24 @@ func TestParseCql(t *testing.T) {
 	}
 }
 
+func TestParseCqlCreate(t *testing.T) {
+	var res interface{}
+	var err error
+	docProts := make(map[string]*Document)
+
+	//TESTCASE: STORE makes the index store documents
+	res, err = ParseCql("IDX.CREATE orders STORE SCHEMA price UINT32 desc STRING", docProts)
+	require.NoError(t, err)
+	require.Equal(t, true, res.(*CqlCreate).StoreDoc)
+	res, err = ParseCql("IDX.CREATE orders SCHEMA price UINT32 desc STRING", docProts)
+	require.NoError(t, err)
+	require.Equal(t, false, res.(*CqlCreate).StoreDoc)
+
+	//TESTCASE: STORE shall precede SCHEMA
+	_, err = ParseCql("IDX.CREATE orders SCHEMA price UINT32 STORE", docProts)
+	require.Error(t, err)
+}
+
 func TestParseCqlSelect(t *testing.T) {
 	var res interface{}
 	var err error
@@ -73,8 +98,8 @@ func TestParseCqlSelect(t *testing.T) {
 	q = res.(*CqlSelect)
 	uintPred, ok = q.UintPreds["price"]
 	require.Equalf(t, true, ok, "UintPred price is gone")
-	require.Equal(t, 30, uintPred.Low)
-	require.Equal(t, 34, uintPred.High)
+	require.Equal(t, uint64(30), uintPred.Low)
+	require.Equal(t, uint64(34), uintPred.High)
 
 	//TESTCASE: FLOAT32
 	valSs := []string{"30", "40.3"}
@@ -85,6 +110,7 @@ func TestParseCqlSelect(t *testing.T) {
 		require.NoError(t, err)
 		vals[i] = val
 		fmt.Printf("FLOAT32 %v\t%v\n", valS, val)
+		require.Equal(t, valS, FormatUintProp(&UintProp{IsFloat: true, ValLen: 4}, val))
 	}
 	res, err = ParseCql("IDX.SELECT orders WHERE priceF32>=30 priceF32<=40.3", docProts)
 	require.NoError(t, err)
@@ -101,6 +127,7 @@ func TestParseCqlSelect(t *testing.T) {
 		require.NoError(t, err)
 		vals[i] = val
 		fmt.Printf("FLOAT64 %v\t%v\n", valS, val)
+		require.Equal(t, valS, FormatUintProp(&UintProp{IsFloat: true, ValLen: 8}, val))
 	}
 	res, err = ParseCql("IDX.SELECT orders WHERE priceF64>=30 priceF64<=40.3", docProts)
 	require.NoError(t, err)
@@ -130,6 +157,24 @@ func TestParseCqlSelect(t *testing.T) {
 	require.Equalf(t, true, ok, "StrPred desc is gone")
 	require.Equal(t, "pen", strings.ToLower(strPred.ContWord))
 
+	//TESTCASE: HIGHLIGHT
+	res, err = ParseCql("IDX.SELECT orders WHERE desc CONTAINS \"pen\" HIGHLIGHT", docProts)
+	require.NoError(t, err)
+	require.Equal(t, true, res.(*CqlSelect).Highlight)
+	res, err = ParseCql("IDX.SELECT orders WHERE price>=30 desc CONTAINS \"pen\" ORDERBY price LIMIT 10 HIGHLIGHT", docProts)
+	require.NoError(t, err)
+	require.Equal(t, true, res.(*CqlSelect).Highlight)
+	require.Equal(t, 10, res.(*CqlSelect).Limit)
+	res, err = ParseCql("IDX.SELECT orders WHERE desc CONTAINS \"pen\"", docProts)
+	require.NoError(t, err)
+	require.Equal(t, false, res.(*CqlSelect).Highlight)
+
+	//TESTCASE: escape sequences of STRING
+	res, err = ParseCql(`IDX.SELECT orders WHERE desc CONTAINS "say \"hi\" \\ \u4e2d"`, docProts)
+	require.NoError(t, err)
+	q = res.(*CqlSelect)
+	require.Equal(t, `say "hi" \ 中`, q.StrPreds["desc"].ContWord)
+
 	tcs := []string{
 		//TESTCASE: invalid query due to multiple StrPred of a property
 		"IDX.SELECT orders WHERE desc CONTAINS \"pen\" desc CONTAINS \"pencil\"",
@@ -139,6 +184,69 @@ func TestParseCqlSelect(t *testing.T) {
 		"IDX.SELECT orders WHERE price>=30 price<=40 type IN [1,3] ORDERBY type",
 		//TESTCASE: invalid query due to mismatching property name
 		"IDX.SELECT orders WHERE prices>=20.2",
+		//TESTCASE: invalid query due to HIGHLIGHT preceding ORDERBY
+		"IDX.SELECT orders WHERE price>=30 HIGHLIGHT ORDERBY price",
+	}
+	for _, tc := range tcs {
+		res, err = ParseCql(tc, docProts)
+		require.Errorf(t, err, "have %+v, want an error", res)
+	}
+}
+
+func TestParseCqlDefaults(t *testing.T) {
+	var res interface{}
+	var err error
+	var c *CqlCreate
+	var ins *CqlInsert
+	var q *CqlSelect
+	var existPred ExistPred
+	var ok bool
+	//Prepare index
+	docProts := make(map[string]*Document)
+	res, err = ParseCql("IDX.CREATE orders SCHEMA object UINT64 price UINT32 DEFAULT 7 type ENUM DEFAULT 2 desc STRING note STRING DEFAULT \"none\"", docProts)
+	require.NoError(t, err)
+	c = res.(*CqlCreate)
+	docProts[c.DocumentWithIdx.Index] = &c.DocumentWithIdx.Doc
+	require.Equal(t, 1, len(c.Defaults.UintProps))
+	require.Equal(t, uint64(7), c.Defaults.UintProps[0].Val)
+	require.Equal(t, uint64(0), c.Doc.UintProps[1].Val)
+	require.Equal(t, uint64(2), c.Defaults.EnumProps[0].Val)
+	require.Equal(t, "none", c.Defaults.StrProps[0].Val)
+
+	//TESTCASE: named values leave the absent properties unset
+	res, err = ParseCql("IDX.INSERT orders 7 price=3 note=\"x\"", docProts)
+	require.NoError(t, err)
+	ins = res.(*CqlInsert)
+	require.Equal(t, uint64(7), ins.Doc.DocID)
+	require.Equal(t, 1, len(ins.Doc.UintProps))
+	require.Equal(t, "price", ins.Doc.UintProps[0].Name)
+	require.Equal(t, uint64(3), ins.Doc.UintProps[0].Val)
+	require.Equal(t, 0, len(ins.Doc.EnumProps))
+	require.Equal(t, 1, len(ins.Doc.StrProps))
+	require.Equal(t, "x", ins.Doc.StrProps[0].Val)
+	//the schema is kept unchanged
+	require.Equal(t, uint64(0), docProts["orders"].UintProps[1].Val)
+
+	//TESTCASE: EXISTS and IS NULL
+	res, err = ParseCql("IDX.SELECT orders WHERE price>=3 note EXISTS desc IS NULL", docProts)
+	require.NoError(t, err)
+	q = res.(*CqlSelect)
+	existPred, ok = q.ExistPreds["note"]
+	require.Equalf(t, true, ok, "ExistPred note is gone")
+	require.Equal(t, true, existPred.Exists)
+	existPred, ok = q.ExistPreds["desc"]
+	require.Equalf(t, true, ok, "ExistPred desc is gone")
+	require.Equal(t, false, existPred.Exists)
+
+	tcs := []string{
+		//TESTCASE: invalid insert due to unknown property
+		"IDX.INSERT orders 8 weight=3",
+		//TESTCASE: invalid insert due to multiple values of a property
+		"IDX.INSERT orders 8 price=3 price=4",
+		//TESTCASE: invalid query due to unknown property
+		"IDX.SELECT orders WHERE weight EXISTS",
+		//TESTCASE: invalid query due to multiple ExistPred of a property
+		"IDX.SELECT orders WHERE note EXISTS note IS NULL",
 	}
 	for _, tc := range tcs {
 		res, err = ParseCql(tc, docProts)
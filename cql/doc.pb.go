@@ -86,6 +86,9 @@ func (*Document) Descriptor() ([]byte, []int) { return fileDescriptorDoc, []int{
 type DocumentWithIdx struct {
 	Doc              Document `protobuf:"bytes,1,opt,name=doc" json:"doc"`
 	Index            string   `protobuf:"bytes,2,opt,name=index" json:"index"`
+	StoreDoc         bool     `protobuf:"varint,3,opt,name=storeDoc" json:"storeDoc"`
+	Version          uint32   `protobuf:"varint,4,opt,name=version" json:"version"`
+	Defaults         Document `protobuf:"bytes,5,opt,name=defaults" json:"defaults"`
 	XXX_unrecognized []byte   `json:"-"`
 }
 
@@ -296,6 +299,25 @@ func (m *DocumentWithIdx) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	i = encodeVarintDoc(dAtA, i, uint64(len(m.Index)))
 	i += copy(dAtA[i:], m.Index)
+	dAtA[i] = 0x18
+	i++
+	if m.StoreDoc {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintDoc(dAtA, i, uint64(m.Version))
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintDoc(dAtA, i, uint64(m.Defaults.Size()))
+	n2, err := m.Defaults.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -431,6 +453,10 @@ func (m *DocumentWithIdx) Size() (n int) {
 	n += 1 + l + sovDoc(uint64(l))
 	l = len(m.Index)
 	n += 1 + l + sovDoc(uint64(l))
+	n += 2
+	n += 1 + sovDoc(uint64(m.Version))
+	l = m.Defaults.Size()
+	n += 1 + l + sovDoc(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1059,6 +1085,75 @@ func (m *DocumentWithIdx) Unmarshal(dAtA []byte) error {
 			}
 			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreDoc", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StoreDoc = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Defaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDoc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDoc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Defaults.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDoc(dAtA[iNdEx:])
@@ -1288,27 +1383,29 @@ var (
 func init() { proto.RegisterFile("doc.proto", fileDescriptorDoc) }
 
 var fileDescriptorDoc = []byte{
-	// 340 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x50, 0xdf, 0x4a, 0xf3, 0x30,
-	0x1c, 0x5d, 0xda, 0xee, 0x5b, 0xfb, 0xdb, 0x27, 0x42, 0x18, 0x12, 0x86, 0xd4, 0x52, 0x10, 0x0a,
-	0xc2, 0x04, 0x6f, 0xf5, 0x6a, 0x6c, 0xc2, 0xc0, 0x0b, 0xa9, 0x8a, 0xd7, 0x25, 0x2d, 0xb3, 0x90,
-	0x25, 0xdb, 0x9a, 0x8d, 0xe1, 0x93, 0xf8, 0x0c, 0x3e, 0xc9, 0x2e, 0x7d, 0x02, 0xd1, 0xf9, 0x22,
-	0xd2, 0x34, 0xb1, 0xd3, 0x5d, 0xe8, 0x5d, 0x72, 0xfe, 0xf0, 0x3b, 0xe7, 0x80, 0x97, 0x0a, 0xda,
-	0x9b, 0xce, 0x85, 0x14, 0xd8, 0xa6, 0x33, 0xd6, 0xed, 0x8c, 0xc5, 0x58, 0xa8, 0xff, 0x69, 0xf9,
-	0xaa, 0xa8, 0xf0, 0x11, 0xdc, 0xbb, 0x9c, 0xcb, 0xeb, 0xb9, 0x98, 0x62, 0x02, 0x0e, 0x4f, 0x26,
-	0x19, 0x41, 0x01, 0x8a, 0xbc, 0xbe, 0xb3, 0x7e, 0x3d, 0x6a, 0xc4, 0x0a, 0xc1, 0x3e, 0xb4, 0xf2,
-	0xe2, 0x92, 0x89, 0x44, 0x12, 0x2b, 0x40, 0x91, 0xab, 0x49, 0x03, 0xe2, 0x43, 0xf8, 0xb7, 0x4c,
-	0xd8, 0x55, 0xc6, 0x89, 0x1d, 0xa0, 0xa8, 0xa9, 0x69, 0x8d, 0xe1, 0x03, 0xb0, 0x97, 0x09, 0x23,
-	0x4e, 0x80, 0x22, 0x47, 0x53, 0x25, 0x10, 0x5e, 0x80, 0x3b, 0xe4, 0x8b, 0xc9, 0x2f, 0xb7, 0xb5,
-	0xdb, 0xfa, 0xe9, 0x3e, 0x87, 0xd6, 0x8d, 0x9c, 0xff, 0xdd, 0xec, 0x6d, 0x9b, 0x9f, 0x11, 0xb8,
-	0x03, 0x41, 0x17, 0x93, 0x8c, 0x4b, 0xdc, 0x85, 0x66, 0x2a, 0xe8, 0x68, 0xa0, 0xfc, 0xe6, 0x46,
-	0x05, 0xe1, 0x13, 0xf0, 0x16, 0x7a, 0x9f, 0x82, 0x58, 0x81, 0x1d, 0xb5, 0xcf, 0xf6, 0x7a, 0x74,
-	0xc6, 0x7a, 0x66, 0xb5, 0xb8, 0xe6, 0x4b, 0x71, 0xa6, 0x0b, 0x15, 0xc4, 0xde, 0x12, 0x9b, 0x9a,
-	0x71, 0xcd, 0xe3, 0x08, 0xdc, 0xa2, 0xca, 0x5f, 0x10, 0x47, 0x69, 0xff, 0x2b, 0xad, 0x2e, 0x15,
-	0x7f, 0xb1, 0xe1, 0x2d, 0xec, 0x9b, 0xac, 0xf7, 0xb9, 0x7c, 0x18, 0xa5, 0x2b, 0x7c, 0x0c, 0x76,
-	0x2a, 0xa8, 0x0a, 0x6c, 0x6e, 0x18, 0x89, 0xa9, 0x99, 0x0a, 0x5a, 0x36, 0xcb, 0x79, 0x9a, 0xad,
-	0xbe, 0x0d, 0x50, 0x41, 0xe1, 0x10, 0xda, 0xc6, 0x32, 0xc8, 0x58, 0x2d, 0x45, 0x3b, 0xd2, 0x7a,
-	0x20, 0x6b, 0x67, 0xa0, 0x7e, 0x67, 0xfd, 0xee, 0x37, 0xd6, 0x1b, 0x1f, 0xbd, 0x6c, 0x7c, 0xf4,
-	0xb6, 0xf1, 0xd1, 0xd3, 0x87, 0xdf, 0xf8, 0x0c, 0x00, 0x00, 0xff, 0xff, 0xc0, 0x64, 0x71, 0x50,
-	0x7d, 0x02, 0x00, 0x00,
+	// 375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8d, 0x51, 0xdd, 0x4a, 0xc3, 0x30,
+	0x18, 0x5d, 0xd7, 0xce, 0xb5, 0xdf, 0x1c, 0x42, 0x18, 0x52, 0x86, 0xd4, 0x51, 0x10, 0x06, 0xc2,
+	0x06, 0xde, 0xea, 0xd5, 0xd8, 0x84, 0x81, 0x17, 0x52, 0x11, 0xaf, 0xcb, 0x1a, 0x67, 0xa1, 0x6b,
+	0x66, 0x93, 0xca, 0xf0, 0x49, 0x7c, 0x06, 0x5f, 0xc4, 0x5d, 0xfa, 0x04, 0xe2, 0xcf, 0x8b, 0x98,
+	0xa4, 0x49, 0xab, 0x0e, 0xd1, 0x8b, 0x40, 0x7a, 0xce, 0xf9, 0x9a, 0xf3, 0x9d, 0x03, 0x4e, 0x44,
+	0x66, 0x83, 0x65, 0x46, 0x18, 0x41, 0xe6, 0xec, 0x36, 0xe9, 0x76, 0xe6, 0x64, 0x4e, 0xe4, 0xf7,
+	0x50, 0xdc, 0x0a, 0xca, 0xbf, 0x07, 0xfb, 0x32, 0x4e, 0xd9, 0x79, 0x46, 0x96, 0xc8, 0x05, 0x2b,
+	0x0d, 0x17, 0xd8, 0x35, 0x7a, 0x46, 0xdf, 0x19, 0x59, 0xeb, 0x97, 0xfd, 0x5a, 0x20, 0x11, 0xe4,
+	0x41, 0x33, 0xa6, 0xa7, 0x09, 0x09, 0x99, 0x5b, 0xe7, 0xa4, 0xad, 0x48, 0x0d, 0xa2, 0x3d, 0xd8,
+	0xba, 0x0b, 0x93, 0x33, 0x9c, 0xba, 0x26, 0xa7, 0x1b, 0x8a, 0x56, 0x18, 0xda, 0x05, 0x93, 0xdf,
+	0x5c, 0x8b, 0x53, 0x96, 0xa2, 0x04, 0xe0, 0x9f, 0x80, 0x3d, 0x49, 0xf3, 0xc5, 0x1f, 0x6f, 0xab,
+	0xe9, 0xfa, 0xcf, 0xe9, 0x63, 0x68, 0x5e, 0xb0, 0xec, 0xff, 0xc3, 0xce, 0xd7, 0xe1, 0x47, 0x03,
+	0xec, 0x31, 0x99, 0xe5, 0x0b, 0x9c, 0x32, 0xd4, 0x85, 0x06, 0xcf, 0x6a, 0x3a, 0x96, 0xf3, 0xfa,
+	0x8d, 0x02, 0x42, 0x87, 0xe0, 0xe4, 0x2a, 0x1f, 0xca, 0x7f, 0x63, 0xf6, 0x5b, 0x47, 0xed, 0x01,
+	0x8f, 0x73, 0xa0, 0x53, 0x0b, 0x2a, 0x5e, 0x88, 0xb1, 0x5a, 0x88, 0xf2, 0x24, 0x2a, 0xb1, 0x5e,
+	0x33, 0xa8, 0x78, 0xd4, 0x07, 0x9b, 0x16, 0xfe, 0x29, 0x8f, 0x46, 0x68, 0xb7, 0xa5, 0x56, 0x2d,
+	0x15, 0x94, 0xac, 0xff, 0x64, 0xc0, 0x8e, 0x36, 0x7b, 0x15, 0xb3, 0x9b, 0x69, 0xb4, 0x42, 0x07,
+	0x60, 0x72, 0x83, 0xd2, 0xb1, 0x7e, 0x44, 0x4b, 0xf4, 0x9e, 0x9c, 0x17, 0xab, 0xc5, 0x69, 0x84,
+	0x57, 0xdf, 0x12, 0x28, 0x20, 0xd4, 0x13, 0x06, 0x48, 0x86, 0xf9, 0x9c, 0xac, 0x4d, 0xb7, 0x5a,
+	0xa2, 0xa2, 0xf6, 0x3b, 0x9c, 0xd1, 0x98, 0xa4, 0xb2, 0xbc, 0xb6, 0xae, 0x5d, 0x81, 0x68, 0x08,
+	0x76, 0x84, 0xaf, 0xc3, 0x3c, 0x61, 0xd4, 0x6d, 0xfc, 0xee, 0xa4, 0x14, 0xf9, 0x13, 0x68, 0x69,
+	0x6e, 0x8c, 0x93, 0xca, 0x9d, 0xb1, 0xe9, 0xae, 0x2c, 0xa5, 0xbe, 0x51, 0xca, 0xa8, 0xb3, 0x7e,
+	0xf3, 0x6a, 0xeb, 0x77, 0xcf, 0x78, 0xe6, 0xe7, 0x95, 0x9f, 0x87, 0x0f, 0xaf, 0xf6, 0x09, 0x3f,
+	0x6d, 0xe7, 0xcc, 0xf1, 0x02, 0x00, 0x00,
 }
@@ -13,30 +13,39 @@ grammar CQL;
 cql
     : create EOF
     | destroy EOF
+    | alter EOF
     | insert EOF
     | del EOF
     | query EOF
     ;
 
-create: 'IDX.CREATE' indexName 'SCHEMA' (uintPropDef)* (enumPropDef)* (strPropDef)*;
+create: 'IDX.CREATE' indexName K_STORE? 'SCHEMA' (uintPropDef)* (enumPropDef)* (strPropDef)*;
 
 destroy: 'IDX.DESTROY' indexName;
 
+alter: 'IDX.ALTER' indexName (alterAdd | alterDrop)+;
+
+alterAdd: 'ADD' (uintPropDef | enumPropDef | strPropDef);
+
+alterDrop: 'DROP' property;
+
 insert: 'IDX.INSERT' document;
 
 del: 'IDX.DEL' document;
 
-query: ('IDX.SELECT' | 'QUERY') indexName 'WHERE' (uintPred)* (enumPred)* (strPred)* orderLimit?;
+query: ('IDX.SELECT' | 'QUERY') indexName 'WHERE' (uintPred)* (enumPred)* (strPred)* (existPred)* orderLimit? K_HIGHLIGHT?;
 
 indexName: IDENTIFIER;
 
-document: indexName docId value+;
+document: indexName docId (value+ | namedValue+);
 
-uintPropDef: property uintType;
+namedValue: property K_EQ value;
 
-enumPropDef: property K_ENUM;
+uintPropDef: property uintType (K_DEFAULT value)?;
 
-strPropDef: property K_STRING;
+enumPropDef: property K_ENUM (K_DEFAULT INT)?;
+
+strPropDef: property K_STRING (K_DEFAULT STRING)?;
 
 orderLimit: 'ORDERBY' order ('LIMIT' limit)?;
 
@@ -67,6 +76,8 @@ enumPred: property K_IN intList;
 
 strPred: property K_CONTAINS STRING;
 
+existPred: property (K_EXISTS | K_IS K_NULL);
+
 compare
     : K_LT
     | K_BT
@@ -89,6 +100,12 @@ K_ENUM: 'ENUM';
 K_STRING: 'STRING';
 K_IN: 'IN';
 K_CONTAINS: 'CONTAINS';
+K_DEFAULT: 'DEFAULT';
+K_EXISTS: 'EXISTS';
+K_IS: 'IS';
+K_NULL: 'NULL';
+K_STORE: 'STORE';
+K_HIGHLIGHT: 'HIGHLIGHT';
 K_LT: '<';
 K_BT: '>';
 K_EQ: '=';
@@ -39,6 +39,24 @@ func (s *BaseCQLListener) EnterDestroy(ctx *DestroyContext) {}
 // ExitDestroy is called when production destroy is exited.
 func (s *BaseCQLListener) ExitDestroy(ctx *DestroyContext) {}
 
+// EnterAlter is called when production alter is entered.
+func (s *BaseCQLListener) EnterAlter(ctx *AlterContext) {}
+
+// ExitAlter is called when production alter is exited.
+func (s *BaseCQLListener) ExitAlter(ctx *AlterContext) {}
+
+// EnterAlterAdd is called when production alterAdd is entered.
+func (s *BaseCQLListener) EnterAlterAdd(ctx *AlterAddContext) {}
+
+// ExitAlterAdd is called when production alterAdd is exited.
+func (s *BaseCQLListener) ExitAlterAdd(ctx *AlterAddContext) {}
+
+// EnterAlterDrop is called when production alterDrop is entered.
+func (s *BaseCQLListener) EnterAlterDrop(ctx *AlterDropContext) {}
+
+// ExitAlterDrop is called when production alterDrop is exited.
+func (s *BaseCQLListener) ExitAlterDrop(ctx *AlterDropContext) {}
+
 // EnterInsert is called when production insert is entered.
 func (s *BaseCQLListener) EnterInsert(ctx *InsertContext) {}
 
@@ -69,6 +87,12 @@ func (s *BaseCQLListener) EnterDocument(ctx *DocumentContext) {}
 // ExitDocument is called when production document is exited.
 func (s *BaseCQLListener) ExitDocument(ctx *DocumentContext) {}
 
+// EnterNamedValue is called when production namedValue is entered.
+func (s *BaseCQLListener) EnterNamedValue(ctx *NamedValueContext) {}
+
+// ExitNamedValue is called when production namedValue is exited.
+func (s *BaseCQLListener) ExitNamedValue(ctx *NamedValueContext) {}
+
 // EnterUintPropDef is called when production uintPropDef is entered.
 func (s *BaseCQLListener) EnterUintPropDef(ctx *UintPropDefContext) {}
 
@@ -141,6 +165,12 @@ func (s *BaseCQLListener) EnterStrPred(ctx *StrPredContext) {}
 // ExitStrPred is called when production strPred is exited.
 func (s *BaseCQLListener) ExitStrPred(ctx *StrPredContext) {}
 
+// EnterExistPred is called when production existPred is entered.
+func (s *BaseCQLListener) EnterExistPred(ctx *ExistPredContext) {}
+
+// ExitExistPred is called when production existPred is exited.
+func (s *BaseCQLListener) ExitExistPred(ctx *ExistPredContext) {}
+
 // EnterCompare is called when production compare is entered.
 func (s *BaseCQLListener) EnterCompare(ctx *CompareContext) {}
 
@@ -20,6 +20,18 @@ func (v *BaseCQLVisitor) VisitDestroy(ctx *DestroyContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseCQLVisitor) VisitAlter(ctx *AlterContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseCQLVisitor) VisitAlterAdd(ctx *AlterAddContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseCQLVisitor) VisitAlterDrop(ctx *AlterDropContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseCQLVisitor) VisitInsert(ctx *InsertContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -40,6 +52,10 @@ func (v *BaseCQLVisitor) VisitDocument(ctx *DocumentContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseCQLVisitor) VisitNamedValue(ctx *NamedValueContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseCQLVisitor) VisitUintPropDef(ctx *UintPropDefContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -88,6 +104,10 @@ func (v *BaseCQLVisitor) VisitStrPred(ctx *StrPredContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseCQLVisitor) VisitExistPred(ctx *ExistPredContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseCQLVisitor) VisitCompare(ctx *CompareContext) interface{} {
 	return v.VisitChildren(ctx)
 }
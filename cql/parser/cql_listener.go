@@ -17,6 +17,15 @@ type CQLListener interface {
 	// EnterDestroy is called when entering the destroy production.
 	EnterDestroy(c *DestroyContext)
 
+	// EnterAlter is called when entering the alter production.
+	EnterAlter(c *AlterContext)
+
+	// EnterAlterAdd is called when entering the alterAdd production.
+	EnterAlterAdd(c *AlterAddContext)
+
+	// EnterAlterDrop is called when entering the alterDrop production.
+	EnterAlterDrop(c *AlterDropContext)
+
 	// EnterInsert is called when entering the insert production.
 	EnterInsert(c *InsertContext)
 
@@ -32,6 +41,9 @@ type CQLListener interface {
 	// EnterDocument is called when entering the document production.
 	EnterDocument(c *DocumentContext)
 
+	// EnterNamedValue is called when entering the namedValue production.
+	EnterNamedValue(c *NamedValueContext)
+
 	// EnterUintPropDef is called when entering the uintPropDef production.
 	EnterUintPropDef(c *UintPropDefContext)
 
@@ -68,6 +80,9 @@ type CQLListener interface {
 	// EnterStrPred is called when entering the strPred production.
 	EnterStrPred(c *StrPredContext)
 
+	// EnterExistPred is called when entering the existPred production.
+	EnterExistPred(c *ExistPredContext)
+
 	// EnterCompare is called when entering the compare production.
 	EnterCompare(c *CompareContext)
 
@@ -86,6 +101,15 @@ type CQLListener interface {
 	// ExitDestroy is called when exiting the destroy production.
 	ExitDestroy(c *DestroyContext)
 
+	// ExitAlter is called when exiting the alter production.
+	ExitAlter(c *AlterContext)
+
+	// ExitAlterAdd is called when exiting the alterAdd production.
+	ExitAlterAdd(c *AlterAddContext)
+
+	// ExitAlterDrop is called when exiting the alterDrop production.
+	ExitAlterDrop(c *AlterDropContext)
+
 	// ExitInsert is called when exiting the insert production.
 	ExitInsert(c *InsertContext)
 
@@ -101,6 +125,9 @@ type CQLListener interface {
 	// ExitDocument is called when exiting the document production.
 	ExitDocument(c *DocumentContext)
 
+	// ExitNamedValue is called when exiting the namedValue production.
+	ExitNamedValue(c *NamedValueContext)
+
 	// ExitUintPropDef is called when exiting the uintPropDef production.
 	ExitUintPropDef(c *UintPropDefContext)
 
@@ -137,6 +164,9 @@ type CQLListener interface {
 	// ExitStrPred is called when exiting the strPred production.
 	ExitStrPred(c *StrPredContext)
 
+	// ExitExistPred is called when exiting the existPred production.
+	ExitExistPred(c *ExistPredContext)
+
 	// ExitCompare is called when exiting the compare production.
 	ExitCompare(c *CompareContext)
 
@@ -16,101 +16,136 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 35, 181,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 44, 249,
 	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
 	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4, 13,
 	9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4, 18, 9,
 	18, 4, 19, 9, 19, 4, 20, 9, 20, 4, 21, 9, 21, 4, 22, 9, 22, 4, 23, 9, 23,
-	4, 24, 9, 24, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
-	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 5, 2, 64, 10, 2, 3, 3, 3, 3, 3, 3, 3,
-	3, 7, 3, 70, 10, 3, 12, 3, 14, 3, 73, 11, 3, 3, 3, 7, 3, 76, 10, 3, 12,
-	3, 14, 3, 79, 11, 3, 3, 3, 7, 3, 82, 10, 3, 12, 3, 14, 3, 85, 11, 3, 3,
-	4, 3, 4, 3, 4, 3, 5, 3, 5, 3, 5, 3, 6, 3, 6, 3, 6, 3, 7, 3, 7, 3, 7, 3,
-	7, 7, 7, 100, 10, 7, 12, 7, 14, 7, 103, 11, 7, 3, 7, 7, 7, 106, 10, 7,
-	12, 7, 14, 7, 109, 11, 7, 3, 7, 7, 7, 112, 10, 7, 12, 7, 14, 7, 115, 11,
-	7, 3, 7, 5, 7, 118, 10, 7, 3, 8, 3, 8, 3, 9, 3, 9, 3, 9, 6, 9, 125, 10,
-	9, 13, 9, 14, 9, 126, 3, 10, 3, 10, 3, 10, 3, 11, 3, 11, 3, 11, 3, 12,
-	3, 12, 3, 12, 3, 13, 3, 13, 3, 13, 3, 13, 5, 13, 142, 10, 13, 3, 14, 3,
-	14, 3, 15, 3, 15, 3, 16, 3, 16, 3, 17, 3, 17, 3, 18, 3, 18, 3, 19, 3, 19,
-	3, 19, 3, 19, 3, 20, 3, 20, 3, 20, 3, 20, 3, 21, 3, 21, 3, 21, 3, 21, 3,
-	22, 3, 22, 3, 23, 3, 23, 3, 23, 3, 23, 7, 23, 172, 10, 23, 12, 23, 14,
-	23, 175, 11, 23, 3, 23, 3, 23, 3, 24, 3, 24, 3, 24, 2, 2, 25, 2, 4, 6,
-	8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42,
-	44, 46, 2, 6, 3, 2, 8, 9, 3, 2, 16, 21, 3, 2, 31, 33, 3, 2, 26, 30, 2,
-	171, 2, 63, 3, 2, 2, 2, 4, 65, 3, 2, 2, 2, 6, 86, 3, 2, 2, 2, 8, 89, 3,
-	2, 2, 2, 10, 92, 3, 2, 2, 2, 12, 95, 3, 2, 2, 2, 14, 119, 3, 2, 2, 2, 16,
-	121, 3, 2, 2, 2, 18, 128, 3, 2, 2, 2, 20, 131, 3, 2, 2, 2, 22, 134, 3,
-	2, 2, 2, 24, 137, 3, 2, 2, 2, 26, 143, 3, 2, 2, 2, 28, 145, 3, 2, 2, 2,
-	30, 147, 3, 2, 2, 2, 32, 149, 3, 2, 2, 2, 34, 151, 3, 2, 2, 2, 36, 153,
-	3, 2, 2, 2, 38, 157, 3, 2, 2, 2, 40, 161, 3, 2, 2, 2, 42, 165, 3, 2, 2,
-	2, 44, 167, 3, 2, 2, 2, 46, 178, 3, 2, 2, 2, 48, 49, 5, 4, 3, 2, 49, 50,
-	7, 2, 2, 3, 50, 64, 3, 2, 2, 2, 51, 52, 5, 6, 4, 2, 52, 53, 7, 2, 2, 3,
-	53, 64, 3, 2, 2, 2, 54, 55, 5, 8, 5, 2, 55, 56, 7, 2, 2, 3, 56, 64, 3,
-	2, 2, 2, 57, 58, 5, 10, 6, 2, 58, 59, 7, 2, 2, 3, 59, 64, 3, 2, 2, 2, 60,
-	61, 5, 12, 7, 2, 61, 62, 7, 2, 2, 3, 62, 64, 3, 2, 2, 2, 63, 48, 3, 2,
-	2, 2, 63, 51, 3, 2, 2, 2, 63, 54, 3, 2, 2, 2, 63, 57, 3, 2, 2, 2, 63, 60,
-	3, 2, 2, 2, 64, 3, 3, 2, 2, 2, 65, 66, 7, 3, 2, 2, 66, 67, 5, 14, 8, 2,
-	67, 71, 7, 4, 2, 2, 68, 70, 5, 18, 10, 2, 69, 68, 3, 2, 2, 2, 70, 73, 3,
-	2, 2, 2, 71, 69, 3, 2, 2, 2, 71, 72, 3, 2, 2, 2, 72, 77, 3, 2, 2, 2, 73,
-	71, 3, 2, 2, 2, 74, 76, 5, 20, 11, 2, 75, 74, 3, 2, 2, 2, 76, 79, 3, 2,
-	2, 2, 77, 75, 3, 2, 2, 2, 77, 78, 3, 2, 2, 2, 78, 83, 3, 2, 2, 2, 79, 77,
-	3, 2, 2, 2, 80, 82, 5, 22, 12, 2, 81, 80, 3, 2, 2, 2, 82, 85, 3, 2, 2,
-	2, 83, 81, 3, 2, 2, 2, 83, 84, 3, 2, 2, 2, 84, 5, 3, 2, 2, 2, 85, 83, 3,
-	2, 2, 2, 86, 87, 7, 5, 2, 2, 87, 88, 5, 14, 8, 2, 88, 7, 3, 2, 2, 2, 89,
-	90, 7, 6, 2, 2, 90, 91, 5, 16, 9, 2, 91, 9, 3, 2, 2, 2, 92, 93, 7, 7, 2,
-	2, 93, 94, 5, 16, 9, 2, 94, 11, 3, 2, 2, 2, 95, 96, 9, 2, 2, 2, 96, 97,
-	5, 14, 8, 2, 97, 101, 7, 10, 2, 2, 98, 100, 5, 36, 19, 2, 99, 98, 3, 2,
-	2, 2, 100, 103, 3, 2, 2, 2, 101, 99, 3, 2, 2, 2, 101, 102, 3, 2, 2, 2,
-	102, 107, 3, 2, 2, 2, 103, 101, 3, 2, 2, 2, 104, 106, 5, 38, 20, 2, 105,
-	104, 3, 2, 2, 2, 106, 109, 3, 2, 2, 2, 107, 105, 3, 2, 2, 2, 107, 108,
-	3, 2, 2, 2, 108, 113, 3, 2, 2, 2, 109, 107, 3, 2, 2, 2, 110, 112, 5, 40,
-	21, 2, 111, 110, 3, 2, 2, 2, 112, 115, 3, 2, 2, 2, 113, 111, 3, 2, 2, 2,
-	113, 114, 3, 2, 2, 2, 114, 117, 3, 2, 2, 2, 115, 113, 3, 2, 2, 2, 116,
-	118, 5, 24, 13, 2, 117, 116, 3, 2, 2, 2, 117, 118, 3, 2, 2, 2, 118, 13,
-	3, 2, 2, 2, 119, 120, 7, 34, 2, 2, 120, 15, 3, 2, 2, 2, 121, 122, 5, 14,
-	8, 2, 122, 124, 5, 32, 17, 2, 123, 125, 5, 34, 18, 2, 124, 123, 3, 2, 2,
-	2, 125, 126, 3, 2, 2, 2, 126, 124, 3, 2, 2, 2, 126, 127, 3, 2, 2, 2, 127,
-	17, 3, 2, 2, 2, 128, 129, 5, 28, 15, 2, 129, 130, 5, 30, 16, 2, 130, 19,
-	3, 2, 2, 2, 131, 132, 5, 28, 15, 2, 132, 133, 7, 22, 2, 2, 133, 21, 3,
-	2, 2, 2, 134, 135, 5, 28, 15, 2, 135, 136, 7, 23, 2, 2, 136, 23, 3, 2,
-	2, 2, 137, 138, 7, 11, 2, 2, 138, 141, 5, 26, 14, 2, 139, 140, 7, 12, 2,
-	2, 140, 142, 5, 46, 24, 2, 141, 139, 3, 2, 2, 2, 141, 142, 3, 2, 2, 2,
-	142, 25, 3, 2, 2, 2, 143, 144, 5, 28, 15, 2, 144, 27, 3, 2, 2, 2, 145,
-	146, 7, 34, 2, 2, 146, 29, 3, 2, 2, 2, 147, 148, 9, 3, 2, 2, 148, 31, 3,
-	2, 2, 2, 149, 150, 7, 33, 2, 2, 150, 33, 3, 2, 2, 2, 151, 152, 9, 4, 2,
-	2, 152, 35, 3, 2, 2, 2, 153, 154, 5, 28, 15, 2, 154, 155, 5, 42, 22, 2,
-	155, 156, 5, 34, 18, 2, 156, 37, 3, 2, 2, 2, 157, 158, 5, 28, 15, 2, 158,
-	159, 7, 24, 2, 2, 159, 160, 5, 44, 23, 2, 160, 39, 3, 2, 2, 2, 161, 162,
-	5, 28, 15, 2, 162, 163, 7, 25, 2, 2, 163, 164, 7, 32, 2, 2, 164, 41, 3,
-	2, 2, 2, 165, 166, 9, 5, 2, 2, 166, 43, 3, 2, 2, 2, 167, 168, 7, 13, 2,
-	2, 168, 173, 7, 33, 2, 2, 169, 170, 7, 14, 2, 2, 170, 172, 7, 33, 2, 2,
-	171, 169, 3, 2, 2, 2, 172, 175, 3, 2, 2, 2, 173, 171, 3, 2, 2, 2, 173,
-	174, 3, 2, 2, 2, 174, 176, 3, 2, 2, 2, 175, 173, 3, 2, 2, 2, 176, 177,
-	7, 15, 2, 2, 177, 45, 3, 2, 2, 2, 178, 179, 7, 33, 2, 2, 179, 47, 3, 2,
-	2, 2, 13, 63, 71, 77, 83, 101, 107, 113, 117, 126, 141, 173,
+	4, 24, 9, 24, 4, 25, 9, 25, 4, 26, 9, 26, 4, 27, 9, 27, 4, 28, 9, 28, 4,
+	29, 9, 29, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 5, 2, 77, 10, 2, 3, 3,
+	3, 3, 3, 3, 5, 3, 82, 10, 3, 3, 3, 3, 3, 7, 3, 86, 10, 3, 12, 3, 14, 3,
+	89, 11, 3, 3, 3, 7, 3, 92, 10, 3, 12, 3, 14, 3, 95, 11, 3, 3, 3, 7, 3,
+	98, 10, 3, 12, 3, 14, 3, 101, 11, 3, 3, 4, 3, 4, 3, 4, 3, 5, 3, 5, 3, 5,
+	3, 5, 6, 5, 110, 10, 5, 13, 5, 14, 5, 111, 3, 6, 3, 6, 3, 6, 3, 6, 5, 6,
+	118, 10, 6, 3, 7, 3, 7, 3, 7, 3, 8, 3, 8, 3, 8, 3, 9, 3, 9, 3, 9, 3, 10,
+	3, 10, 3, 10, 3, 10, 7, 10, 133, 10, 10, 12, 10, 14, 10, 136, 11, 10, 3,
+	10, 7, 10, 139, 10, 10, 12, 10, 14, 10, 142, 11, 10, 3, 10, 7, 10, 145,
+	10, 10, 12, 10, 14, 10, 148, 11, 10, 3, 10, 7, 10, 151, 10, 10, 12, 10,
+	14, 10, 154, 11, 10, 3, 10, 5, 10, 157, 10, 10, 3, 10, 5, 10, 160, 10,
+	10, 3, 11, 3, 11, 3, 12, 3, 12, 3, 12, 6, 12, 167, 10, 12, 13, 12, 14,
+	12, 168, 3, 12, 6, 12, 172, 10, 12, 13, 12, 14, 12, 173, 5, 12, 176, 10,
+	12, 3, 13, 3, 13, 3, 13, 3, 13, 3, 14, 3, 14, 3, 14, 3, 14, 5, 14, 186,
+	10, 14, 3, 15, 3, 15, 3, 15, 3, 15, 5, 15, 192, 10, 15, 3, 16, 3, 16, 3,
+	16, 3, 16, 5, 16, 198, 10, 16, 3, 17, 3, 17, 3, 17, 3, 17, 5, 17, 204,
+	10, 17, 3, 18, 3, 18, 3, 19, 3, 19, 3, 20, 3, 20, 3, 21, 3, 21, 3, 22,
+	3, 22, 3, 23, 3, 23, 3, 23, 3, 23, 3, 24, 3, 24, 3, 24, 3, 24, 3, 25, 3,
+	25, 3, 25, 3, 25, 3, 26, 3, 26, 3, 26, 3, 26, 5, 26, 232, 10, 26, 3, 27,
+	3, 27, 3, 28, 3, 28, 3, 28, 3, 28, 7, 28, 240, 10, 28, 12, 28, 14, 28,
+	243, 11, 28, 3, 28, 3, 28, 3, 29, 3, 29, 3, 29, 2, 2, 30, 2, 4, 6, 8, 10,
+	12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46,
+	48, 50, 52, 54, 56, 2, 6, 3, 2, 11, 12, 3, 2, 19, 24, 3, 2, 40, 42, 3,
+	2, 35, 39, 2, 248, 2, 76, 3, 2, 2, 2, 4, 78, 3, 2, 2, 2, 6, 102, 3, 2,
+	2, 2, 8, 105, 3, 2, 2, 2, 10, 113, 3, 2, 2, 2, 12, 119, 3, 2, 2, 2, 14,
+	122, 3, 2, 2, 2, 16, 125, 3, 2, 2, 2, 18, 128, 3, 2, 2, 2, 20, 161, 3,
+	2, 2, 2, 22, 163, 3, 2, 2, 2, 24, 177, 3, 2, 2, 2, 26, 181, 3, 2, 2, 2,
+	28, 187, 3, 2, 2, 2, 30, 193, 3, 2, 2, 2, 32, 199, 3, 2, 2, 2, 34, 205,
+	3, 2, 2, 2, 36, 207, 3, 2, 2, 2, 38, 209, 3, 2, 2, 2, 40, 211, 3, 2, 2,
+	2, 42, 213, 3, 2, 2, 2, 44, 215, 3, 2, 2, 2, 46, 219, 3, 2, 2, 2, 48, 223,
+	3, 2, 2, 2, 50, 227, 3, 2, 2, 2, 52, 233, 3, 2, 2, 2, 54, 235, 3, 2, 2,
+	2, 56, 246, 3, 2, 2, 2, 58, 59, 5, 4, 3, 2, 59, 60, 7, 2, 2, 3, 60, 77,
+	3, 2, 2, 2, 61, 62, 5, 6, 4, 2, 62, 63, 7, 2, 2, 3, 63, 77, 3, 2, 2, 2,
+	64, 65, 5, 8, 5, 2, 65, 66, 7, 2, 2, 3, 66, 77, 3, 2, 2, 2, 67, 68, 5,
+	14, 8, 2, 68, 69, 7, 2, 2, 3, 69, 77, 3, 2, 2, 2, 70, 71, 5, 16, 9, 2,
+	71, 72, 7, 2, 2, 3, 72, 77, 3, 2, 2, 2, 73, 74, 5, 18, 10, 2, 74, 75, 7,
+	2, 2, 3, 75, 77, 3, 2, 2, 2, 76, 58, 3, 2, 2, 2, 76, 61, 3, 2, 2, 2, 76,
+	64, 3, 2, 2, 2, 76, 67, 3, 2, 2, 2, 76, 70, 3, 2, 2, 2, 76, 73, 3, 2, 2,
+	2, 77, 3, 3, 2, 2, 2, 78, 79, 7, 3, 2, 2, 79, 81, 5, 20, 11, 2, 80, 82,
+	7, 33, 2, 2, 81, 80, 3, 2, 2, 2, 81, 82, 3, 2, 2, 2, 82, 83, 3, 2, 2, 2,
+	83, 87, 7, 4, 2, 2, 84, 86, 5, 26, 14, 2, 85, 84, 3, 2, 2, 2, 86, 89, 3,
+	2, 2, 2, 87, 85, 3, 2, 2, 2, 87, 88, 3, 2, 2, 2, 88, 93, 3, 2, 2, 2, 89,
+	87, 3, 2, 2, 2, 90, 92, 5, 28, 15, 2, 91, 90, 3, 2, 2, 2, 92, 95, 3, 2,
+	2, 2, 93, 91, 3, 2, 2, 2, 93, 94, 3, 2, 2, 2, 94, 99, 3, 2, 2, 2, 95, 93,
+	3, 2, 2, 2, 96, 98, 5, 30, 16, 2, 97, 96, 3, 2, 2, 2, 98, 101, 3, 2, 2,
+	2, 99, 97, 3, 2, 2, 2, 99, 100, 3, 2, 2, 2, 100, 5, 3, 2, 2, 2, 101, 99,
+	3, 2, 2, 2, 102, 103, 7, 5, 2, 2, 103, 104, 5, 20, 11, 2, 104, 7, 3, 2,
+	2, 2, 105, 106, 7, 6, 2, 2, 106, 109, 5, 20, 11, 2, 107, 110, 5, 10, 6,
+	2, 108, 110, 5, 12, 7, 2, 109, 107, 3, 2, 2, 2, 109, 108, 3, 2, 2, 2, 110,
+	111, 3, 2, 2, 2, 111, 109, 3, 2, 2, 2, 111, 112, 3, 2, 2, 2, 112, 9, 3,
+	2, 2, 2, 113, 117, 7, 7, 2, 2, 114, 118, 5, 26, 14, 2, 115, 118, 5, 28,
+	15, 2, 116, 118, 5, 30, 16, 2, 117, 114, 3, 2, 2, 2, 117, 115, 3, 2, 2,
+	2, 117, 116, 3, 2, 2, 2, 118, 11, 3, 2, 2, 2, 119, 120, 7, 8, 2, 2, 120,
+	121, 5, 36, 19, 2, 121, 13, 3, 2, 2, 2, 122, 123, 7, 9, 2, 2, 123, 124,
+	5, 22, 12, 2, 124, 15, 3, 2, 2, 2, 125, 126, 7, 10, 2, 2, 126, 127, 5,
+	22, 12, 2, 127, 17, 3, 2, 2, 2, 128, 129, 9, 2, 2, 2, 129, 130, 5, 20,
+	11, 2, 130, 134, 7, 13, 2, 2, 131, 133, 5, 44, 23, 2, 132, 131, 3, 2, 2,
+	2, 133, 136, 3, 2, 2, 2, 134, 132, 3, 2, 2, 2, 134, 135, 3, 2, 2, 2, 135,
+	140, 3, 2, 2, 2, 136, 134, 3, 2, 2, 2, 137, 139, 5, 46, 24, 2, 138, 137,
+	3, 2, 2, 2, 139, 142, 3, 2, 2, 2, 140, 138, 3, 2, 2, 2, 140, 141, 3, 2,
+	2, 2, 141, 146, 3, 2, 2, 2, 142, 140, 3, 2, 2, 2, 143, 145, 5, 48, 25,
+	2, 144, 143, 3, 2, 2, 2, 145, 148, 3, 2, 2, 2, 146, 144, 3, 2, 2, 2, 146,
+	147, 3, 2, 2, 2, 147, 152, 3, 2, 2, 2, 148, 146, 3, 2, 2, 2, 149, 151,
+	5, 50, 26, 2, 150, 149, 3, 2, 2, 2, 151, 154, 3, 2, 2, 2, 152, 150, 3,
+	2, 2, 2, 152, 153, 3, 2, 2, 2, 153, 156, 3, 2, 2, 2, 154, 152, 3, 2, 2,
+	2, 155, 157, 5, 32, 17, 2, 156, 155, 3, 2, 2, 2, 156, 157, 3, 2, 2, 2,
+	157, 159, 3, 2, 2, 2, 158, 160, 7, 34, 2, 2, 159, 158, 3, 2, 2, 2, 159,
+	160, 3, 2, 2, 2, 160, 19, 3, 2, 2, 2, 161, 162, 7, 43, 2, 2, 162, 21, 3,
+	2, 2, 2, 163, 164, 5, 20, 11, 2, 164, 175, 5, 40, 21, 2, 165, 167, 5, 42,
+	22, 2, 166, 165, 3, 2, 2, 2, 167, 168, 3, 2, 2, 2, 168, 166, 3, 2, 2, 2,
+	168, 169, 3, 2, 2, 2, 169, 176, 3, 2, 2, 2, 170, 172, 5, 24, 13, 2, 171,
+	170, 3, 2, 2, 2, 172, 173, 3, 2, 2, 2, 173, 171, 3, 2, 2, 2, 173, 174,
+	3, 2, 2, 2, 174, 176, 3, 2, 2, 2, 175, 166, 3, 2, 2, 2, 175, 171, 3, 2,
+	2, 2, 176, 23, 3, 2, 2, 2, 177, 178, 5, 36, 19, 2, 178, 179, 7, 37, 2,
+	2, 179, 180, 5, 42, 22, 2, 180, 25, 3, 2, 2, 2, 181, 182, 5, 36, 19, 2,
+	182, 185, 5, 38, 20, 2, 183, 184, 7, 29, 2, 2, 184, 186, 5, 42, 22, 2,
+	185, 183, 3, 2, 2, 2, 185, 186, 3, 2, 2, 2, 186, 27, 3, 2, 2, 2, 187, 188,
+	5, 36, 19, 2, 188, 191, 7, 25, 2, 2, 189, 190, 7, 29, 2, 2, 190, 192, 7,
+	42, 2, 2, 191, 189, 3, 2, 2, 2, 191, 192, 3, 2, 2, 2, 192, 29, 3, 2, 2,
+	2, 193, 194, 5, 36, 19, 2, 194, 197, 7, 26, 2, 2, 195, 196, 7, 29, 2, 2,
+	196, 198, 7, 41, 2, 2, 197, 195, 3, 2, 2, 2, 197, 198, 3, 2, 2, 2, 198,
+	31, 3, 2, 2, 2, 199, 200, 7, 14, 2, 2, 200, 203, 5, 34, 18, 2, 201, 202,
+	7, 15, 2, 2, 202, 204, 5, 56, 29, 2, 203, 201, 3, 2, 2, 2, 203, 204, 3,
+	2, 2, 2, 204, 33, 3, 2, 2, 2, 205, 206, 5, 36, 19, 2, 206, 35, 3, 2, 2,
+	2, 207, 208, 7, 43, 2, 2, 208, 37, 3, 2, 2, 2, 209, 210, 9, 3, 2, 2, 210,
+	39, 3, 2, 2, 2, 211, 212, 7, 42, 2, 2, 212, 41, 3, 2, 2, 2, 213, 214, 9,
+	4, 2, 2, 214, 43, 3, 2, 2, 2, 215, 216, 5, 36, 19, 2, 216, 217, 5, 52,
+	27, 2, 217, 218, 5, 42, 22, 2, 218, 45, 3, 2, 2, 2, 219, 220, 5, 36, 19,
+	2, 220, 221, 7, 27, 2, 2, 221, 222, 5, 54, 28, 2, 222, 47, 3, 2, 2, 2,
+	223, 224, 5, 36, 19, 2, 224, 225, 7, 28, 2, 2, 225, 226, 7, 41, 2, 2, 226,
+	49, 3, 2, 2, 2, 227, 231, 5, 36, 19, 2, 228, 232, 7, 30, 2, 2, 229, 230,
+	7, 31, 2, 2, 230, 232, 7, 32, 2, 2, 231, 228, 3, 2, 2, 2, 231, 229, 3,
+	2, 2, 2, 232, 51, 3, 2, 2, 2, 233, 234, 9, 5, 2, 2, 234, 53, 3, 2, 2, 2,
+	235, 236, 7, 16, 2, 2, 236, 241, 7, 42, 2, 2, 237, 238, 7, 17, 2, 2, 238,
+	240, 7, 42, 2, 2, 239, 237, 3, 2, 2, 2, 240, 243, 3, 2, 2, 2, 241, 239,
+	3, 2, 2, 2, 241, 242, 3, 2, 2, 2, 242, 244, 3, 2, 2, 2, 243, 241, 3, 2,
+	2, 2, 244, 245, 7, 18, 2, 2, 245, 55, 3, 2, 2, 2, 246, 247, 7, 42, 2, 2,
+	247, 57, 3, 2, 2, 2, 25, 76, 81, 87, 93, 99, 109, 111, 117, 134, 140, 146,
+	152, 156, 159, 168, 173, 175, 185, 191, 197, 203, 231, 241,
 }
 var deserializer = antlr.NewATNDeserializer(nil)
 var deserializedATN = deserializer.DeserializeFromUInt16(parserATN)
 
 var literalNames = []string{
-	"", "'IDX.CREATE'", "'SCHEMA'", "'IDX.DESTROY'", "'IDX.INSERT'", "'IDX.DEL'",
-	"'IDX.SELECT'", "'QUERY'", "'WHERE'", "'ORDERBY'", "'LIMIT'", "'['", "','",
-	"']'", "'UINT8'", "'UINT16'", "'UINT32'", "'UINT64'", "'FLOAT32'", "'FLOAT64'",
-	"'ENUM'", "'STRING'", "'IN'", "'CONTAINS'", "'<'", "'>'", "'='", "'<='",
-	"'>='",
+	"", "'IDX.CREATE'", "'SCHEMA'", "'IDX.DESTROY'", "'IDX.ALTER'", "'ADD'",
+	"'DROP'", "'IDX.INSERT'", "'IDX.DEL'", "'IDX.SELECT'", "'QUERY'", "'WHERE'",
+	"'ORDERBY'", "'LIMIT'", "'['", "','", "']'", "'UINT8'", "'UINT16'", "'UINT32'",
+	"'UINT64'", "'FLOAT32'", "'FLOAT64'", "'ENUM'", "'STRING'", "'IN'", "'CONTAINS'",
+	"'DEFAULT'", "'EXISTS'", "'IS'", "'NULL'", "'STORE'", "'HIGHLIGHT'", "'<'",
+	"'>'", "'='", "'<='", "'>='",
 }
 var symbolicNames = []string{
-	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "K_UINT8", "K_UINT16",
-	"K_UINT32", "K_UINT64", "K_FLOAT32", "K_FLOAT64", "K_ENUM", "K_STRING",
-	"K_IN", "K_CONTAINS", "K_LT", "K_BT", "K_EQ", "K_LE", "K_BE", "FLOAT_LIT",
+	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "K_UINT8",
+	"K_UINT16", "K_UINT32", "K_UINT64", "K_FLOAT32", "K_FLOAT64", "K_ENUM",
+	"K_STRING", "K_IN", "K_CONTAINS", "K_DEFAULT", "K_EXISTS", "K_IS", "K_NULL",
+	"K_STORE", "K_HIGHLIGHT", "K_LT", "K_BT", "K_EQ", "K_LE", "K_BE", "FLOAT_LIT",
 	"STRING", "INT", "IDENTIFIER", "WS",
 }
 
 var ruleNames = []string{
-	"cql", "create", "destroy", "insert", "del", "query", "indexName", "document",
-	"uintPropDef", "enumPropDef", "strPropDef", "orderLimit", "order", "property",
-	"uintType", "docId", "value", "uintPred", "enumPred", "strPred", "compare",
-	"intList", "limit",
+	"cql", "create", "destroy", "alter", "alterAdd", "alterDrop", "insert",
+	"del", "query", "indexName", "document", "namedValue", "uintPropDef", "enumPropDef",
+	"strPropDef", "orderLimit", "order", "property", "uintType", "docId", "value",
+	"uintPred", "enumPred", "strPred", "existPred", "compare", "intList", "limit",
 }
 var decisionToDFA = make([]*antlr.DFA, len(deserializedATN.DecisionToState))
 
@@ -140,40 +175,49 @@ func NewCQLParser(input antlr.TokenStream) *CQLParser {
 
 // CQLParser tokens.
 const (
-	CQLParserEOF        = antlr.TokenEOF
-	CQLParserT__0       = 1
-	CQLParserT__1       = 2
-	CQLParserT__2       = 3
-	CQLParserT__3       = 4
-	CQLParserT__4       = 5
-	CQLParserT__5       = 6
-	CQLParserT__6       = 7
-	CQLParserT__7       = 8
-	CQLParserT__8       = 9
-	CQLParserT__9       = 10
-	CQLParserT__10      = 11
-	CQLParserT__11      = 12
-	CQLParserT__12      = 13
-	CQLParserK_UINT8    = 14
-	CQLParserK_UINT16   = 15
-	CQLParserK_UINT32   = 16
-	CQLParserK_UINT64   = 17
-	CQLParserK_FLOAT32  = 18
-	CQLParserK_FLOAT64  = 19
-	CQLParserK_ENUM     = 20
-	CQLParserK_STRING   = 21
-	CQLParserK_IN       = 22
-	CQLParserK_CONTAINS = 23
-	CQLParserK_LT       = 24
-	CQLParserK_BT       = 25
-	CQLParserK_EQ       = 26
-	CQLParserK_LE       = 27
-	CQLParserK_BE       = 28
-	CQLParserFLOAT_LIT  = 29
-	CQLParserSTRING     = 30
-	CQLParserINT        = 31
-	CQLParserIDENTIFIER = 32
-	CQLParserWS         = 33
+	CQLParserEOF         = antlr.TokenEOF
+	CQLParserT__0        = 1
+	CQLParserT__1        = 2
+	CQLParserT__2        = 3
+	CQLParserT__3        = 4
+	CQLParserT__4        = 5
+	CQLParserT__5        = 6
+	CQLParserT__6        = 7
+	CQLParserT__7        = 8
+	CQLParserT__8        = 9
+	CQLParserT__9        = 10
+	CQLParserT__10       = 11
+	CQLParserT__11       = 12
+	CQLParserT__12       = 13
+	CQLParserT__13       = 14
+	CQLParserT__14       = 15
+	CQLParserT__15       = 16
+	CQLParserK_UINT8     = 17
+	CQLParserK_UINT16    = 18
+	CQLParserK_UINT32    = 19
+	CQLParserK_UINT64    = 20
+	CQLParserK_FLOAT32   = 21
+	CQLParserK_FLOAT64   = 22
+	CQLParserK_ENUM      = 23
+	CQLParserK_STRING    = 24
+	CQLParserK_IN        = 25
+	CQLParserK_CONTAINS  = 26
+	CQLParserK_DEFAULT   = 27
+	CQLParserK_EXISTS    = 28
+	CQLParserK_IS        = 29
+	CQLParserK_NULL      = 30
+	CQLParserK_STORE     = 31
+	CQLParserK_HIGHLIGHT = 32
+	CQLParserK_LT        = 33
+	CQLParserK_BT        = 34
+	CQLParserK_EQ        = 35
+	CQLParserK_LE        = 36
+	CQLParserK_BE        = 37
+	CQLParserFLOAT_LIT   = 38
+	CQLParserSTRING      = 39
+	CQLParserINT         = 40
+	CQLParserIDENTIFIER  = 41
+	CQLParserWS          = 42
 )
 
 // CQLParser rules.
@@ -181,26 +225,31 @@ const (
 	CQLParserRULE_cql         = 0
 	CQLParserRULE_create      = 1
 	CQLParserRULE_destroy     = 2
-	CQLParserRULE_insert      = 3
-	CQLParserRULE_del         = 4
-	CQLParserRULE_query       = 5
-	CQLParserRULE_indexName   = 6
-	CQLParserRULE_document    = 7
-	CQLParserRULE_uintPropDef = 8
-	CQLParserRULE_enumPropDef = 9
-	CQLParserRULE_strPropDef  = 10
-	CQLParserRULE_orderLimit  = 11
-	CQLParserRULE_order       = 12
-	CQLParserRULE_property    = 13
-	CQLParserRULE_uintType    = 14
-	CQLParserRULE_docId       = 15
-	CQLParserRULE_value       = 16
-	CQLParserRULE_uintPred    = 17
-	CQLParserRULE_enumPred    = 18
-	CQLParserRULE_strPred     = 19
-	CQLParserRULE_compare     = 20
-	CQLParserRULE_intList     = 21
-	CQLParserRULE_limit       = 22
+	CQLParserRULE_alter       = 3
+	CQLParserRULE_alterAdd    = 4
+	CQLParserRULE_alterDrop   = 5
+	CQLParserRULE_insert      = 6
+	CQLParserRULE_del         = 7
+	CQLParserRULE_query       = 8
+	CQLParserRULE_indexName   = 9
+	CQLParserRULE_document    = 10
+	CQLParserRULE_namedValue  = 11
+	CQLParserRULE_uintPropDef = 12
+	CQLParserRULE_enumPropDef = 13
+	CQLParserRULE_strPropDef  = 14
+	CQLParserRULE_orderLimit  = 15
+	CQLParserRULE_order       = 16
+	CQLParserRULE_property    = 17
+	CQLParserRULE_uintType    = 18
+	CQLParserRULE_docId       = 19
+	CQLParserRULE_value       = 20
+	CQLParserRULE_uintPred    = 21
+	CQLParserRULE_enumPred    = 22
+	CQLParserRULE_strPred     = 23
+	CQLParserRULE_existPred   = 24
+	CQLParserRULE_compare     = 25
+	CQLParserRULE_intList     = 26
+	CQLParserRULE_limit       = 27
 )
 
 // ICqlContext is an interface to support dynamic dispatch.
@@ -265,6 +314,16 @@ func (s *CqlContext) Destroy() IDestroyContext {
 	return t.(IDestroyContext)
 }
 
+func (s *CqlContext) Alter() IAlterContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAlterContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAlterContext)
+}
+
 func (s *CqlContext) Insert() IInsertContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IInsertContext)(nil)).Elem(), 0)
 
@@ -345,62 +404,73 @@ func (p *CQLParser) Cql() (localctx ICqlContext) {
 		}
 	}()
 
-	p.SetState(61)
+	p.SetState(74)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case CQLParserT__0:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(46)
+			p.SetState(56)
 			p.Create()
 		}
 		{
-			p.SetState(47)
+			p.SetState(57)
 			p.Match(CQLParserEOF)
 		}
 
 	case CQLParserT__2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(49)
+			p.SetState(59)
 			p.Destroy()
 		}
 		{
-			p.SetState(50)
+			p.SetState(60)
 			p.Match(CQLParserEOF)
 		}
 
 	case CQLParserT__3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(52)
-			p.Insert()
+			p.SetState(62)
+			p.Alter()
 		}
 		{
-			p.SetState(53)
+			p.SetState(63)
 			p.Match(CQLParserEOF)
 		}
 
-	case CQLParserT__4:
+	case CQLParserT__6:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(55)
-			p.Del()
+			p.SetState(65)
+			p.Insert()
 		}
 		{
-			p.SetState(56)
+			p.SetState(66)
 			p.Match(CQLParserEOF)
 		}
 
-	case CQLParserT__5, CQLParserT__6:
+	case CQLParserT__7:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(58)
+			p.SetState(68)
+			p.Del()
+		}
+		{
+			p.SetState(69)
+			p.Match(CQLParserEOF)
+		}
+
+	case CQLParserT__8, CQLParserT__9:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(71)
 			p.Query()
 		}
 		{
-			p.SetState(59)
+			p.SetState(72)
 			p.Match(CQLParserEOF)
 		}
 
@@ -459,6 +529,10 @@ func (s *CreateContext) IndexName() IIndexNameContext {
 	return t.(IIndexNameContext)
 }
 
+func (s *CreateContext) K_STORE() antlr.TerminalNode {
+	return s.GetToken(CQLParserK_STORE, 0)
+}
+
 func (s *CreateContext) AllUintPropDef() []IUintPropDefContext {
 	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IUintPropDefContext)(nil)).Elem())
 	var tst = make([]IUintPropDefContext, len(ts))
@@ -583,60 +657,71 @@ func (p *CQLParser) Create() (localctx ICreateContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(63)
+		p.SetState(76)
 		p.Match(CQLParserT__0)
 	}
 	{
-		p.SetState(64)
+		p.SetState(77)
 		p.IndexName()
 	}
+	p.SetState(79)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CQLParserK_STORE {
+		{
+			p.SetState(78)
+			p.Match(CQLParserK_STORE)
+		}
+
+	}
 	{
-		p.SetState(65)
+		p.SetState(81)
 		p.Match(CQLParserT__1)
 	}
-	p.SetState(69)
+	p.SetState(85)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 1, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 2, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(66)
+				p.SetState(82)
 				p.UintPropDef()
 			}
 
 		}
-		p.SetState(71)
+		p.SetState(87)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 1, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 2, p.GetParserRuleContext())
 	}
-	p.SetState(75)
+	p.SetState(91)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 2, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 3, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(72)
+				p.SetState(88)
 				p.EnumPropDef()
 			}
 
 		}
-		p.SetState(77)
+		p.SetState(93)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 2, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 3, p.GetParserRuleContext())
 	}
-	p.SetState(81)
+	p.SetState(97)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == CQLParserIDENTIFIER {
 		{
-			p.SetState(78)
+			p.SetState(94)
 			p.StrPropDef()
 		}
 
-		p.SetState(83)
+		p.SetState(99)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -744,98 +829,145 @@ func (p *CQLParser) Destroy() (localctx IDestroyContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(84)
+		p.SetState(100)
 		p.Match(CQLParserT__2)
 	}
 	{
-		p.SetState(85)
+		p.SetState(101)
 		p.IndexName()
 	}
 
 	return localctx
 }
 
-// IInsertContext is an interface to support dynamic dispatch.
-type IInsertContext interface {
+// IAlterContext is an interface to support dynamic dispatch.
+type IAlterContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsInsertContext differentiates from other interfaces.
-	IsInsertContext()
+	// IsAlterContext differentiates from other interfaces.
+	IsAlterContext()
 }
 
-type InsertContext struct {
+type AlterContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyInsertContext() *InsertContext {
-	var p = new(InsertContext)
+func NewEmptyAlterContext() *AlterContext {
+	var p = new(AlterContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = CQLParserRULE_insert
+	p.RuleIndex = CQLParserRULE_alter
 	return p
 }
 
-func (*InsertContext) IsInsertContext() {}
+func (*AlterContext) IsAlterContext() {}
 
-func NewInsertContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *InsertContext {
-	var p = new(InsertContext)
+func NewAlterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AlterContext {
+	var p = new(AlterContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = CQLParserRULE_insert
+	p.RuleIndex = CQLParserRULE_alter
 
 	return p
 }
 
-func (s *InsertContext) GetParser() antlr.Parser { return s.parser }
+func (s *AlterContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *InsertContext) Document() IDocumentContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDocumentContext)(nil)).Elem(), 0)
+func (s *AlterContext) IndexName() IIndexNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIndexNameContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IDocumentContext)
+	return t.(IIndexNameContext)
 }
 
-func (s *InsertContext) GetRuleContext() antlr.RuleContext {
+func (s *AlterContext) AllAlterAdd() []IAlterAddContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IAlterAddContext)(nil)).Elem())
+	var tst = make([]IAlterAddContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IAlterAddContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *AlterContext) AlterAdd(i int) IAlterAddContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAlterAddContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAlterAddContext)
+}
+
+func (s *AlterContext) AllAlterDrop() []IAlterDropContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IAlterDropContext)(nil)).Elem())
+	var tst = make([]IAlterDropContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IAlterDropContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *AlterContext) AlterDrop(i int) IAlterDropContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAlterDropContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAlterDropContext)
+}
+
+func (s *AlterContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *InsertContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *AlterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *InsertContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *AlterContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(CQLListener); ok {
-		listenerT.EnterInsert(s)
+		listenerT.EnterAlter(s)
 	}
 }
 
-func (s *InsertContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *AlterContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(CQLListener); ok {
-		listenerT.ExitInsert(s)
+		listenerT.ExitAlter(s)
 	}
 }
 
-func (s *InsertContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *AlterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case CQLVisitor:
-		return t.VisitInsert(s)
+		return t.VisitAlter(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *CQLParser) Insert() (localctx IInsertContext) {
-	localctx = NewInsertContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 6, CQLParserRULE_insert)
+func (p *CQLParser) Alter() (localctx IAlterContext) {
+	localctx = NewAlterContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 6, CQLParserRULE_alter)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -855,98 +987,147 @@ func (p *CQLParser) Insert() (localctx IInsertContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(87)
+		p.SetState(103)
 		p.Match(CQLParserT__3)
 	}
 	{
-		p.SetState(88)
-		p.Document()
+		p.SetState(104)
+		p.IndexName()
+	}
+	p.SetState(107)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for ok := true; ok; ok = _la == CQLParserT__4 || _la == CQLParserT__5 {
+		p.SetState(107)
+		p.GetErrorHandler().Sync(p)
+
+		switch p.GetTokenStream().LA(1) {
+		case CQLParserT__4:
+			{
+				p.SetState(105)
+				p.AlterAdd()
+			}
+
+		case CQLParserT__5:
+			{
+				p.SetState(106)
+				p.AlterDrop()
+			}
+
+		default:
+			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		}
+
+		p.SetState(109)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 	}
 
 	return localctx
 }
 
-// IDelContext is an interface to support dynamic dispatch.
-type IDelContext interface {
+// IAlterAddContext is an interface to support dynamic dispatch.
+type IAlterAddContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsDelContext differentiates from other interfaces.
-	IsDelContext()
+	// IsAlterAddContext differentiates from other interfaces.
+	IsAlterAddContext()
 }
 
-type DelContext struct {
+type AlterAddContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyDelContext() *DelContext {
-	var p = new(DelContext)
+func NewEmptyAlterAddContext() *AlterAddContext {
+	var p = new(AlterAddContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = CQLParserRULE_del
+	p.RuleIndex = CQLParserRULE_alterAdd
 	return p
 }
 
-func (*DelContext) IsDelContext() {}
+func (*AlterAddContext) IsAlterAddContext() {}
 
-func NewDelContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DelContext {
-	var p = new(DelContext)
+func NewAlterAddContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AlterAddContext {
+	var p = new(AlterAddContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = CQLParserRULE_del
+	p.RuleIndex = CQLParserRULE_alterAdd
 
 	return p
 }
 
-func (s *DelContext) GetParser() antlr.Parser { return s.parser }
+func (s *AlterAddContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *DelContext) Document() IDocumentContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDocumentContext)(nil)).Elem(), 0)
+func (s *AlterAddContext) UintPropDef() IUintPropDefContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IUintPropDefContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IDocumentContext)
+	return t.(IUintPropDefContext)
 }
 
-func (s *DelContext) GetRuleContext() antlr.RuleContext {
+func (s *AlterAddContext) EnumPropDef() IEnumPropDefContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEnumPropDefContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEnumPropDefContext)
+}
+
+func (s *AlterAddContext) StrPropDef() IStrPropDefContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStrPropDefContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStrPropDefContext)
+}
+
+func (s *AlterAddContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *DelContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *AlterAddContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *DelContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *AlterAddContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(CQLListener); ok {
-		listenerT.EnterDel(s)
+		listenerT.EnterAlterAdd(s)
 	}
 }
 
-func (s *DelContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *AlterAddContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(CQLListener); ok {
-		listenerT.ExitDel(s)
+		listenerT.ExitAlterAdd(s)
 	}
 }
 
-func (s *DelContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *AlterAddContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case CQLVisitor:
-		return t.VisitDel(s)
+		return t.VisitAlterAdd(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *CQLParser) Del() (localctx IDelContext) {
-	localctx = NewDelContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 8, CQLParserRULE_del)
+func (p *CQLParser) AlterAdd() (localctx IAlterAddContext) {
+	localctx = NewAlterAddContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 8, CQLParserRULE_alterAdd)
 
 	defer func() {
 		p.ExitRule()
@@ -966,134 +1147,509 @@ func (p *CQLParser) Del() (localctx IDelContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(90)
+		p.SetState(111)
 		p.Match(CQLParserT__4)
 	}
-	{
-		p.SetState(91)
-		p.Document()
+	p.SetState(115)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 7, p.GetParserRuleContext()) {
+	case 1:
+		{
+			p.SetState(112)
+			p.UintPropDef()
+		}
+
+	case 2:
+		{
+			p.SetState(113)
+			p.EnumPropDef()
+		}
+
+	case 3:
+		{
+			p.SetState(114)
+			p.StrPropDef()
+		}
+
 	}
 
 	return localctx
 }
 
-// IQueryContext is an interface to support dynamic dispatch.
-type IQueryContext interface {
+// IAlterDropContext is an interface to support dynamic dispatch.
+type IAlterDropContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsQueryContext differentiates from other interfaces.
-	IsQueryContext()
+	// IsAlterDropContext differentiates from other interfaces.
+	IsAlterDropContext()
 }
 
-type QueryContext struct {
+type AlterDropContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyQueryContext() *QueryContext {
-	var p = new(QueryContext)
+func NewEmptyAlterDropContext() *AlterDropContext {
+	var p = new(AlterDropContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = CQLParserRULE_query
+	p.RuleIndex = CQLParserRULE_alterDrop
 	return p
 }
 
-func (*QueryContext) IsQueryContext() {}
+func (*AlterDropContext) IsAlterDropContext() {}
 
-func NewQueryContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *QueryContext {
-	var p = new(QueryContext)
+func NewAlterDropContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AlterDropContext {
+	var p = new(AlterDropContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = CQLParserRULE_query
+	p.RuleIndex = CQLParserRULE_alterDrop
 
 	return p
 }
 
-func (s *QueryContext) GetParser() antlr.Parser { return s.parser }
+func (s *AlterDropContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *QueryContext) IndexName() IIndexNameContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIndexNameContext)(nil)).Elem(), 0)
+func (s *AlterDropContext) Property() IPropertyContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPropertyContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IIndexNameContext)
+	return t.(IPropertyContext)
 }
 
-func (s *QueryContext) AllUintPred() []IUintPredContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IUintPredContext)(nil)).Elem())
-	var tst = make([]IUintPredContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IUintPredContext)
-		}
-	}
-
-	return tst
+func (s *AlterDropContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *QueryContext) UintPred(i int) IUintPredContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IUintPredContext)(nil)).Elem(), i)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IUintPredContext)
+func (s *AlterDropContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *QueryContext) AllEnumPred() []IEnumPredContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IEnumPredContext)(nil)).Elem())
-	var tst = make([]IEnumPredContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IEnumPredContext)
-		}
+func (s *AlterDropContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLListener); ok {
+		listenerT.EnterAlterDrop(s)
 	}
-
-	return tst
 }
 
-func (s *QueryContext) EnumPred(i int) IEnumPredContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEnumPredContext)(nil)).Elem(), i)
-
-	if t == nil {
-		return nil
+func (s *AlterDropContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLListener); ok {
+		listenerT.ExitAlterDrop(s)
 	}
-
-	return t.(IEnumPredContext)
 }
 
-func (s *QueryContext) AllStrPred() []IStrPredContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IStrPredContext)(nil)).Elem())
-	var tst = make([]IStrPredContext, len(ts))
+func (s *AlterDropContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case CQLVisitor:
+		return t.VisitAlterDrop(s)
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IStrPredContext)
-		}
+	default:
+		return t.VisitChildren(s)
 	}
-
-	return tst
 }
 
-func (s *QueryContext) StrPred(i int) IStrPredContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStrPredContext)(nil)).Elem(), i)
+func (p *CQLParser) AlterDrop() (localctx IAlterDropContext) {
+	localctx = NewAlterDropContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 10, CQLParserRULE_alterDrop)
 
-	if t == nil {
-		return nil
-	}
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(117)
+		p.Match(CQLParserT__5)
+	}
+	{
+		p.SetState(118)
+		p.Property()
+	}
+
+	return localctx
+}
+
+// IInsertContext is an interface to support dynamic dispatch.
+type IInsertContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsInsertContext differentiates from other interfaces.
+	IsInsertContext()
+}
+
+type InsertContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyInsertContext() *InsertContext {
+	var p = new(InsertContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = CQLParserRULE_insert
+	return p
+}
+
+func (*InsertContext) IsInsertContext() {}
+
+func NewInsertContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *InsertContext {
+	var p = new(InsertContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = CQLParserRULE_insert
+
+	return p
+}
+
+func (s *InsertContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *InsertContext) Document() IDocumentContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDocumentContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDocumentContext)
+}
+
+func (s *InsertContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *InsertContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *InsertContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLListener); ok {
+		listenerT.EnterInsert(s)
+	}
+}
+
+func (s *InsertContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLListener); ok {
+		listenerT.ExitInsert(s)
+	}
+}
+
+func (s *InsertContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case CQLVisitor:
+		return t.VisitInsert(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *CQLParser) Insert() (localctx IInsertContext) {
+	localctx = NewInsertContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 12, CQLParserRULE_insert)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(120)
+		p.Match(CQLParserT__6)
+	}
+	{
+		p.SetState(121)
+		p.Document()
+	}
+
+	return localctx
+}
+
+// IDelContext is an interface to support dynamic dispatch.
+type IDelContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsDelContext differentiates from other interfaces.
+	IsDelContext()
+}
+
+type DelContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDelContext() *DelContext {
+	var p = new(DelContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = CQLParserRULE_del
+	return p
+}
+
+func (*DelContext) IsDelContext() {}
+
+func NewDelContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DelContext {
+	var p = new(DelContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = CQLParserRULE_del
+
+	return p
+}
+
+func (s *DelContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DelContext) Document() IDocumentContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDocumentContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDocumentContext)
+}
+
+func (s *DelContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DelContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DelContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLListener); ok {
+		listenerT.EnterDel(s)
+	}
+}
+
+func (s *DelContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLListener); ok {
+		listenerT.ExitDel(s)
+	}
+}
+
+func (s *DelContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case CQLVisitor:
+		return t.VisitDel(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *CQLParser) Del() (localctx IDelContext) {
+	localctx = NewDelContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 14, CQLParserRULE_del)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(123)
+		p.Match(CQLParserT__7)
+	}
+	{
+		p.SetState(124)
+		p.Document()
+	}
+
+	return localctx
+}
+
+// IQueryContext is an interface to support dynamic dispatch.
+type IQueryContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsQueryContext differentiates from other interfaces.
+	IsQueryContext()
+}
+
+type QueryContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyQueryContext() *QueryContext {
+	var p = new(QueryContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = CQLParserRULE_query
+	return p
+}
+
+func (*QueryContext) IsQueryContext() {}
+
+func NewQueryContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *QueryContext {
+	var p = new(QueryContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = CQLParserRULE_query
+
+	return p
+}
+
+func (s *QueryContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *QueryContext) IndexName() IIndexNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIndexNameContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIndexNameContext)
+}
+
+func (s *QueryContext) AllUintPred() []IUintPredContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IUintPredContext)(nil)).Elem())
+	var tst = make([]IUintPredContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IUintPredContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *QueryContext) UintPred(i int) IUintPredContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IUintPredContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUintPredContext)
+}
+
+func (s *QueryContext) AllEnumPred() []IEnumPredContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IEnumPredContext)(nil)).Elem())
+	var tst = make([]IEnumPredContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IEnumPredContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *QueryContext) EnumPred(i int) IEnumPredContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEnumPredContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEnumPredContext)
+}
+
+func (s *QueryContext) AllStrPred() []IStrPredContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IStrPredContext)(nil)).Elem())
+	var tst = make([]IStrPredContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IStrPredContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *QueryContext) StrPred(i int) IStrPredContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStrPredContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
 
 	return t.(IStrPredContext)
 }
 
+func (s *QueryContext) AllExistPred() []IExistPredContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExistPredContext)(nil)).Elem())
+	var tst = make([]IExistPredContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExistPredContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *QueryContext) ExistPred(i int) IExistPredContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExistPredContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExistPredContext)
+}
+
 func (s *QueryContext) OrderLimit() IOrderLimitContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOrderLimitContext)(nil)).Elem(), 0)
 
@@ -1104,6 +1660,10 @@ func (s *QueryContext) OrderLimit() IOrderLimitContext {
 	return t.(IOrderLimitContext)
 }
 
+func (s *QueryContext) K_HIGHLIGHT() antlr.TerminalNode {
+	return s.GetToken(CQLParserK_HIGHLIGHT, 0)
+}
+
 func (s *QueryContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -1136,7 +1696,7 @@ func (s *QueryContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CQLParser) Query() (localctx IQueryContext) {
 	localctx = NewQueryContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 10, CQLParserRULE_query)
+	p.EnterRule(localctx, 16, CQLParserRULE_query)
 	var _la int
 
 	defer func() {
@@ -1158,79 +1718,106 @@ func (p *CQLParser) Query() (localctx IQueryContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(93)
+	p.SetState(126)
 	_la = p.GetTokenStream().LA(1)
 
-	if !(_la == CQLParserT__5 || _la == CQLParserT__6) {
+	if !(_la == CQLParserT__8 || _la == CQLParserT__9) {
 		p.GetErrorHandler().RecoverInline(p)
 	} else {
 		p.GetErrorHandler().ReportMatch(p)
 		p.Consume()
 	}
 	{
-		p.SetState(94)
+		p.SetState(127)
 		p.IndexName()
 	}
 	{
-		p.SetState(95)
-		p.Match(CQLParserT__7)
+		p.SetState(128)
+		p.Match(CQLParserT__10)
 	}
-	p.SetState(99)
+	p.SetState(132)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 4, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 8, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(96)
+				p.SetState(129)
 				p.UintPred()
 			}
 
 		}
-		p.SetState(101)
+		p.SetState(134)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 4, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 8, p.GetParserRuleContext())
 	}
-	p.SetState(105)
+	p.SetState(138)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 5, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 9, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(102)
+				p.SetState(135)
 				p.EnumPred()
 			}
 
 		}
-		p.SetState(107)
+		p.SetState(140)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 9, p.GetParserRuleContext())
+	}
+	p.SetState(144)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 10, p.GetParserRuleContext())
+
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(141)
+				p.StrPred()
+			}
+
+		}
+		p.SetState(146)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 5, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 10, p.GetParserRuleContext())
 	}
-	p.SetState(111)
+	p.SetState(150)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == CQLParserIDENTIFIER {
 		{
-			p.SetState(108)
-			p.StrPred()
+			p.SetState(147)
+			p.ExistPred()
 		}
 
-		p.SetState(113)
+		p.SetState(152)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
-	p.SetState(115)
+	p.SetState(154)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == CQLParserT__8 {
+	if _la == CQLParserT__11 {
 		{
-			p.SetState(114)
+			p.SetState(153)
 			p.OrderLimit()
 		}
 
+	}
+	p.SetState(157)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CQLParserK_HIGHLIGHT {
+		{
+			p.SetState(156)
+			p.Match(CQLParserK_HIGHLIGHT)
+		}
+
 	}
 
 	return localctx
@@ -1308,108 +1895,296 @@ func (s *IndexNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	}
 }
 
-func (p *CQLParser) IndexName() (localctx IIndexNameContext) {
-	localctx = NewIndexNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 12, CQLParserRULE_indexName)
+func (p *CQLParser) IndexName() (localctx IIndexNameContext) {
+	localctx = NewIndexNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 18, CQLParserRULE_indexName)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(159)
+		p.Match(CQLParserIDENTIFIER)
+	}
+
+	return localctx
+}
+
+// IDocumentContext is an interface to support dynamic dispatch.
+type IDocumentContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsDocumentContext differentiates from other interfaces.
+	IsDocumentContext()
+}
+
+type DocumentContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDocumentContext() *DocumentContext {
+	var p = new(DocumentContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = CQLParserRULE_document
+	return p
+}
+
+func (*DocumentContext) IsDocumentContext() {}
+
+func NewDocumentContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DocumentContext {
+	var p = new(DocumentContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = CQLParserRULE_document
+
+	return p
+}
+
+func (s *DocumentContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DocumentContext) IndexName() IIndexNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIndexNameContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIndexNameContext)
+}
+
+func (s *DocumentContext) DocId() IDocIdContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDocIdContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDocIdContext)
+}
+
+func (s *DocumentContext) AllValue() []IValueContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IValueContext)(nil)).Elem())
+	var tst = make([]IValueContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IValueContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *DocumentContext) Value(i int) IValueContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IValueContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IValueContext)
+}
+
+func (s *DocumentContext) AllNamedValue() []INamedValueContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*INamedValueContext)(nil)).Elem())
+	var tst = make([]INamedValueContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(INamedValueContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *DocumentContext) NamedValue(i int) INamedValueContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*INamedValueContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INamedValueContext)
+}
+
+func (s *DocumentContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DocumentContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DocumentContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLListener); ok {
+		listenerT.EnterDocument(s)
+	}
+}
+
+func (s *DocumentContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLListener); ok {
+		listenerT.ExitDocument(s)
+	}
+}
+
+func (s *DocumentContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case CQLVisitor:
+		return t.VisitDocument(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *CQLParser) Document() (localctx IDocumentContext) {
+	localctx = NewDocumentContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 20, CQLParserRULE_document)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(161)
+		p.IndexName()
+	}
+	{
+		p.SetState(162)
+		p.DocId()
+	}
+	p.SetState(173)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case CQLParserFLOAT_LIT, CQLParserSTRING, CQLParserINT:
+		p.SetState(164)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for ok := true; ok; ok = (((_la-38)&-(0x1f+1)) == 0 && ((1<<uint((_la-38)))&((1<<(CQLParserFLOAT_LIT-38))|(1<<(CQLParserSTRING-38))|(1<<(CQLParserINT-38)))) != 0) {
+			{
+				p.SetState(163)
+				p.Value()
+			}
+
+			p.SetState(166)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
 
-	defer func() {
-		p.ExitRule()
-	}()
+	case CQLParserIDENTIFIER:
+		p.SetState(169)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 
-	defer func() {
-		if err := recover(); err != nil {
-			if v, ok := err.(antlr.RecognitionException); ok {
-				localctx.SetException(v)
-				p.GetErrorHandler().ReportError(p, v)
-				p.GetErrorHandler().Recover(p, v)
-			} else {
-				panic(err)
+		for ok := true; ok; ok = _la == CQLParserIDENTIFIER {
+			{
+				p.SetState(168)
+				p.NamedValue()
 			}
+
+			p.SetState(171)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
 		}
-	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(117)
-		p.Match(CQLParserIDENTIFIER)
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// IDocumentContext is an interface to support dynamic dispatch.
-type IDocumentContext interface {
+// INamedValueContext is an interface to support dynamic dispatch.
+type INamedValueContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsDocumentContext differentiates from other interfaces.
-	IsDocumentContext()
+	// IsNamedValueContext differentiates from other interfaces.
+	IsNamedValueContext()
 }
 
-type DocumentContext struct {
+type NamedValueContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyDocumentContext() *DocumentContext {
-	var p = new(DocumentContext)
+func NewEmptyNamedValueContext() *NamedValueContext {
+	var p = new(NamedValueContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = CQLParserRULE_document
+	p.RuleIndex = CQLParserRULE_namedValue
 	return p
 }
 
-func (*DocumentContext) IsDocumentContext() {}
+func (*NamedValueContext) IsNamedValueContext() {}
 
-func NewDocumentContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DocumentContext {
-	var p = new(DocumentContext)
+func NewNamedValueContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NamedValueContext {
+	var p = new(NamedValueContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = CQLParserRULE_document
+	p.RuleIndex = CQLParserRULE_namedValue
 
 	return p
 }
 
-func (s *DocumentContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *DocumentContext) IndexName() IIndexNameContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIndexNameContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IIndexNameContext)
-}
+func (s *NamedValueContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *DocumentContext) DocId() IDocIdContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDocIdContext)(nil)).Elem(), 0)
+func (s *NamedValueContext) Property() IPropertyContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPropertyContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IDocIdContext)
+	return t.(IPropertyContext)
 }
 
-func (s *DocumentContext) AllValue() []IValueContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IValueContext)(nil)).Elem())
-	var tst = make([]IValueContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IValueContext)
-		}
-	}
-
-	return tst
+func (s *NamedValueContext) K_EQ() antlr.TerminalNode {
+	return s.GetToken(CQLParserK_EQ, 0)
 }
 
-func (s *DocumentContext) Value(i int) IValueContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IValueContext)(nil)).Elem(), i)
+func (s *NamedValueContext) Value() IValueContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IValueContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
@@ -1418,40 +2193,39 @@ func (s *DocumentContext) Value(i int) IValueContext {
 	return t.(IValueContext)
 }
 
-func (s *DocumentContext) GetRuleContext() antlr.RuleContext {
+func (s *NamedValueContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *DocumentContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *NamedValueContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *DocumentContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *NamedValueContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(CQLListener); ok {
-		listenerT.EnterDocument(s)
+		listenerT.EnterNamedValue(s)
 	}
 }
 
-func (s *DocumentContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *NamedValueContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(CQLListener); ok {
-		listenerT.ExitDocument(s)
+		listenerT.ExitNamedValue(s)
 	}
 }
 
-func (s *DocumentContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *NamedValueContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case CQLVisitor:
-		return t.VisitDocument(s)
+		return t.VisitNamedValue(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *CQLParser) Document() (localctx IDocumentContext) {
-	localctx = NewDocumentContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 14, CQLParserRULE_document)
-	var _la int
+func (p *CQLParser) NamedValue() (localctx INamedValueContext) {
+	localctx = NewNamedValueContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 22, CQLParserRULE_namedValue)
 
 	defer func() {
 		p.ExitRule()
@@ -1471,26 +2245,16 @@ func (p *CQLParser) Document() (localctx IDocumentContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(119)
-		p.IndexName()
+		p.SetState(175)
+		p.Property()
 	}
 	{
-		p.SetState(120)
-		p.DocId()
+		p.SetState(176)
+		p.Match(CQLParserK_EQ)
 	}
-	p.SetState(122)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	for ok := true; ok; ok = (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CQLParserFLOAT_LIT)|(1<<CQLParserSTRING)|(1<<CQLParserINT))) != 0) {
-		{
-			p.SetState(121)
-			p.Value()
-		}
-
-		p.SetState(124)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+	{
+		p.SetState(177)
+		p.Value()
 	}
 
 	return localctx
@@ -1554,6 +2318,20 @@ func (s *UintPropDefContext) UintType() IUintTypeContext {
 	return t.(IUintTypeContext)
 }
 
+func (s *UintPropDefContext) K_DEFAULT() antlr.TerminalNode {
+	return s.GetToken(CQLParserK_DEFAULT, 0)
+}
+
+func (s *UintPropDefContext) Value() IValueContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IValueContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IValueContext)
+}
+
 func (s *UintPropDefContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -1586,7 +2364,8 @@ func (s *UintPropDefContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *CQLParser) UintPropDef() (localctx IUintPropDefContext) {
 	localctx = NewUintPropDefContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 16, CQLParserRULE_uintPropDef)
+	p.EnterRule(localctx, 24, CQLParserRULE_uintPropDef)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -1606,13 +2385,28 @@ func (p *CQLParser) UintPropDef() (localctx IUintPropDefContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(126)
+		p.SetState(179)
 		p.Property()
 	}
 	{
-		p.SetState(127)
+		p.SetState(180)
 		p.UintType()
 	}
+	p.SetState(183)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CQLParserK_DEFAULT {
+		{
+			p.SetState(181)
+			p.Match(CQLParserK_DEFAULT)
+		}
+		{
+			p.SetState(182)
+			p.Value()
+		}
+
+	}
 
 	return localctx
 }
@@ -1669,6 +2463,14 @@ func (s *EnumPropDefContext) K_ENUM() antlr.TerminalNode {
 	return s.GetToken(CQLParserK_ENUM, 0)
 }
 
+func (s *EnumPropDefContext) K_DEFAULT() antlr.TerminalNode {
+	return s.GetToken(CQLParserK_DEFAULT, 0)
+}
+
+func (s *EnumPropDefContext) INT() antlr.TerminalNode {
+	return s.GetToken(CQLParserINT, 0)
+}
+
 func (s *EnumPropDefContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -1701,7 +2503,8 @@ func (s *EnumPropDefContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *CQLParser) EnumPropDef() (localctx IEnumPropDefContext) {
 	localctx = NewEnumPropDefContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 18, CQLParserRULE_enumPropDef)
+	p.EnterRule(localctx, 26, CQLParserRULE_enumPropDef)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -1721,13 +2524,28 @@ func (p *CQLParser) EnumPropDef() (localctx IEnumPropDefContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(129)
+		p.SetState(185)
 		p.Property()
 	}
 	{
-		p.SetState(130)
+		p.SetState(186)
 		p.Match(CQLParserK_ENUM)
 	}
+	p.SetState(189)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CQLParserK_DEFAULT {
+		{
+			p.SetState(187)
+			p.Match(CQLParserK_DEFAULT)
+		}
+		{
+			p.SetState(188)
+			p.Match(CQLParserINT)
+		}
+
+	}
 
 	return localctx
 }
@@ -1784,6 +2602,14 @@ func (s *StrPropDefContext) K_STRING() antlr.TerminalNode {
 	return s.GetToken(CQLParserK_STRING, 0)
 }
 
+func (s *StrPropDefContext) K_DEFAULT() antlr.TerminalNode {
+	return s.GetToken(CQLParserK_DEFAULT, 0)
+}
+
+func (s *StrPropDefContext) STRING() antlr.TerminalNode {
+	return s.GetToken(CQLParserSTRING, 0)
+}
+
 func (s *StrPropDefContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -1816,7 +2642,8 @@ func (s *StrPropDefContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CQLParser) StrPropDef() (localctx IStrPropDefContext) {
 	localctx = NewStrPropDefContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 20, CQLParserRULE_strPropDef)
+	p.EnterRule(localctx, 28, CQLParserRULE_strPropDef)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -1836,13 +2663,28 @@ func (p *CQLParser) StrPropDef() (localctx IStrPropDefContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(132)
+		p.SetState(191)
 		p.Property()
 	}
 	{
-		p.SetState(133)
+		p.SetState(192)
 		p.Match(CQLParserK_STRING)
 	}
+	p.SetState(195)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == CQLParserK_DEFAULT {
+		{
+			p.SetState(193)
+			p.Match(CQLParserK_DEFAULT)
+		}
+		{
+			p.SetState(194)
+			p.Match(CQLParserSTRING)
+		}
+
+	}
 
 	return localctx
 }
@@ -1937,7 +2779,7 @@ func (s *OrderLimitContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CQLParser) OrderLimit() (localctx IOrderLimitContext) {
 	localctx = NewOrderLimitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 22, CQLParserRULE_orderLimit)
+	p.EnterRule(localctx, 30, CQLParserRULE_orderLimit)
 	var _la int
 
 	defer func() {
@@ -1958,24 +2800,24 @@ func (p *CQLParser) OrderLimit() (localctx IOrderLimitContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(135)
-		p.Match(CQLParserT__8)
+		p.SetState(197)
+		p.Match(CQLParserT__11)
 	}
 	{
-		p.SetState(136)
+		p.SetState(198)
 		p.Order()
 	}
-	p.SetState(139)
+	p.SetState(201)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == CQLParserT__9 {
+	if _la == CQLParserT__12 {
 		{
-			p.SetState(137)
-			p.Match(CQLParserT__9)
+			p.SetState(199)
+			p.Match(CQLParserT__12)
 		}
 		{
-			p.SetState(138)
+			p.SetState(200)
 			p.Limit()
 		}
 
@@ -2064,7 +2906,7 @@ func (s *OrderContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CQLParser) Order() (localctx IOrderContext) {
 	localctx = NewOrderContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 24, CQLParserRULE_order)
+	p.EnterRule(localctx, 32, CQLParserRULE_order)
 
 	defer func() {
 		p.ExitRule()
@@ -2084,7 +2926,7 @@ func (p *CQLParser) Order() (localctx IOrderContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(141)
+		p.SetState(203)
 		p.Property()
 	}
 
@@ -2165,7 +3007,7 @@ func (s *PropertyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CQLParser) Property() (localctx IPropertyContext) {
 	localctx = NewPropertyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 26, CQLParserRULE_property)
+	p.EnterRule(localctx, 34, CQLParserRULE_property)
 
 	defer func() {
 		p.ExitRule()
@@ -2185,7 +3027,7 @@ func (p *CQLParser) Property() (localctx IPropertyContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(143)
+		p.SetState(205)
 		p.Match(CQLParserIDENTIFIER)
 	}
 
@@ -2286,7 +3128,7 @@ func (s *UintTypeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CQLParser) UintType() (localctx IUintTypeContext) {
 	localctx = NewUintTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 28, CQLParserRULE_uintType)
+	p.EnterRule(localctx, 36, CQLParserRULE_uintType)
 	var _la int
 
 	defer func() {
@@ -2306,7 +3148,7 @@ func (p *CQLParser) UintType() (localctx IUintTypeContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(145)
+	p.SetState(207)
 	_la = p.GetTokenStream().LA(1)
 
 	if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CQLParserK_UINT8)|(1<<CQLParserK_UINT16)|(1<<CQLParserK_UINT32)|(1<<CQLParserK_UINT64)|(1<<CQLParserK_FLOAT32)|(1<<CQLParserK_FLOAT64))) != 0) {
@@ -2393,7 +3235,7 @@ func (s *DocIdContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CQLParser) DocId() (localctx IDocIdContext) {
 	localctx = NewDocIdContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 30, CQLParserRULE_docId)
+	p.EnterRule(localctx, 38, CQLParserRULE_docId)
 
 	defer func() {
 		p.ExitRule()
@@ -2413,7 +3255,7 @@ func (p *CQLParser) DocId() (localctx IDocIdContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(147)
+		p.SetState(209)
 		p.Match(CQLParserINT)
 	}
 
@@ -2502,7 +3344,7 @@ func (s *ValueContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CQLParser) Value() (localctx IValueContext) {
 	localctx = NewValueContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 32, CQLParserRULE_value)
+	p.EnterRule(localctx, 40, CQLParserRULE_value)
 	var _la int
 
 	defer func() {
@@ -2522,10 +3364,10 @@ func (p *CQLParser) Value() (localctx IValueContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(149)
+	p.SetState(211)
 	_la = p.GetTokenStream().LA(1)
 
-	if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CQLParserFLOAT_LIT)|(1<<CQLParserSTRING)|(1<<CQLParserINT))) != 0) {
+	if !(((_la-38)&-(0x1f+1)) == 0 && ((1<<uint((_la-38)))&((1<<(CQLParserFLOAT_LIT-38))|(1<<(CQLParserSTRING-38))|(1<<(CQLParserINT-38)))) != 0) {
 		p.GetErrorHandler().RecoverInline(p)
 	} else {
 		p.GetErrorHandler().ReportMatch(p)
@@ -2635,7 +3477,7 @@ func (s *UintPredContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CQLParser) UintPred() (localctx IUintPredContext) {
 	localctx = NewUintPredContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 34, CQLParserRULE_uintPred)
+	p.EnterRule(localctx, 42, CQLParserRULE_uintPred)
 
 	defer func() {
 		p.ExitRule()
@@ -2655,15 +3497,15 @@ func (p *CQLParser) UintPred() (localctx IUintPredContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(151)
+		p.SetState(213)
 		p.Property()
 	}
 	{
-		p.SetState(152)
+		p.SetState(214)
 		p.Compare()
 	}
 	{
-		p.SetState(153)
+		p.SetState(215)
 		p.Value()
 	}
 
@@ -2764,7 +3606,7 @@ func (s *EnumPredContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CQLParser) EnumPred() (localctx IEnumPredContext) {
 	localctx = NewEnumPredContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 36, CQLParserRULE_enumPred)
+	p.EnterRule(localctx, 44, CQLParserRULE_enumPred)
 
 	defer func() {
 		p.ExitRule()
@@ -2784,15 +3626,15 @@ func (p *CQLParser) EnumPred() (localctx IEnumPredContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(155)
+		p.SetState(217)
 		p.Property()
 	}
 	{
-		p.SetState(156)
+		p.SetState(218)
 		p.Match(CQLParserK_IN)
 	}
 	{
-		p.SetState(157)
+		p.SetState(219)
 		p.IntList()
 	}
 
@@ -2887,7 +3729,7 @@ func (s *StrPredContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CQLParser) StrPred() (localctx IStrPredContext) {
 	localctx = NewStrPredContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 38, CQLParserRULE_strPred)
+	p.EnterRule(localctx, 46, CQLParserRULE_strPred)
 
 	defer func() {
 		p.ExitRule()
@@ -2907,21 +3749,163 @@ func (p *CQLParser) StrPred() (localctx IStrPredContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(159)
+		p.SetState(221)
 		p.Property()
 	}
 	{
-		p.SetState(160)
+		p.SetState(222)
 		p.Match(CQLParserK_CONTAINS)
 	}
 	{
-		p.SetState(161)
+		p.SetState(223)
 		p.Match(CQLParserSTRING)
 	}
 
 	return localctx
 }
 
+// IExistPredContext is an interface to support dynamic dispatch.
+type IExistPredContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsExistPredContext differentiates from other interfaces.
+	IsExistPredContext()
+}
+
+type ExistPredContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyExistPredContext() *ExistPredContext {
+	var p = new(ExistPredContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = CQLParserRULE_existPred
+	return p
+}
+
+func (*ExistPredContext) IsExistPredContext() {}
+
+func NewExistPredContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExistPredContext {
+	var p = new(ExistPredContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = CQLParserRULE_existPred
+
+	return p
+}
+
+func (s *ExistPredContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExistPredContext) Property() IPropertyContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPropertyContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPropertyContext)
+}
+
+func (s *ExistPredContext) K_EXISTS() antlr.TerminalNode {
+	return s.GetToken(CQLParserK_EXISTS, 0)
+}
+
+func (s *ExistPredContext) K_IS() antlr.TerminalNode {
+	return s.GetToken(CQLParserK_IS, 0)
+}
+
+func (s *ExistPredContext) K_NULL() antlr.TerminalNode {
+	return s.GetToken(CQLParserK_NULL, 0)
+}
+
+func (s *ExistPredContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExistPredContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ExistPredContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLListener); ok {
+		listenerT.EnterExistPred(s)
+	}
+}
+
+func (s *ExistPredContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CQLListener); ok {
+		listenerT.ExitExistPred(s)
+	}
+}
+
+func (s *ExistPredContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case CQLVisitor:
+		return t.VisitExistPred(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *CQLParser) ExistPred() (localctx IExistPredContext) {
+	localctx = NewExistPredContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 48, CQLParserRULE_existPred)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(225)
+		p.Property()
+	}
+	p.SetState(229)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case CQLParserK_EXISTS:
+		{
+			p.SetState(226)
+			p.Match(CQLParserK_EXISTS)
+		}
+
+	case CQLParserK_IS:
+		{
+			p.SetState(227)
+			p.Match(CQLParserK_IS)
+		}
+		{
+			p.SetState(228)
+			p.Match(CQLParserK_NULL)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
 // ICompareContext is an interface to support dynamic dispatch.
 type ICompareContext interface {
 	antlr.ParserRuleContext
@@ -3012,7 +3996,7 @@ func (s *CompareContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CQLParser) Compare() (localctx ICompareContext) {
 	localctx = NewCompareContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 40, CQLParserRULE_compare)
+	p.EnterRule(localctx, 50, CQLParserRULE_compare)
 	var _la int
 
 	defer func() {
@@ -3032,10 +4016,10 @@ func (p *CQLParser) Compare() (localctx ICompareContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(163)
+	p.SetState(231)
 	_la = p.GetTokenStream().LA(1)
 
-	if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CQLParserK_LT)|(1<<CQLParserK_BT)|(1<<CQLParserK_EQ)|(1<<CQLParserK_LE)|(1<<CQLParserK_BE))) != 0) {
+	if !(((_la-33)&-(0x1f+1)) == 0 && ((1<<uint((_la-33)))&((1<<(CQLParserK_LT-33))|(1<<(CQLParserK_BT-33))|(1<<(CQLParserK_EQ-33))|(1<<(CQLParserK_LE-33))|(1<<(CQLParserK_BE-33)))) != 0) {
 		p.GetErrorHandler().RecoverInline(p)
 	} else {
 		p.GetErrorHandler().ReportMatch(p)
@@ -3123,7 +4107,7 @@ func (s *IntListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CQLParser) IntList() (localctx IIntListContext) {
 	localctx = NewIntListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 42, CQLParserRULE_intList)
+	p.EnterRule(localctx, 52, CQLParserRULE_intList)
 	var _la int
 
 	defer func() {
@@ -3144,34 +4128,34 @@ func (p *CQLParser) IntList() (localctx IIntListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(165)
-		p.Match(CQLParserT__10)
+		p.SetState(233)
+		p.Match(CQLParserT__13)
 	}
 	{
-		p.SetState(166)
+		p.SetState(234)
 		p.Match(CQLParserINT)
 	}
-	p.SetState(171)
+	p.SetState(239)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == CQLParserT__11 {
+	for _la == CQLParserT__14 {
 		{
-			p.SetState(167)
-			p.Match(CQLParserT__11)
+			p.SetState(235)
+			p.Match(CQLParserT__14)
 		}
 		{
-			p.SetState(168)
+			p.SetState(236)
 			p.Match(CQLParserINT)
 		}
 
-		p.SetState(173)
+		p.SetState(241)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(174)
-		p.Match(CQLParserT__12)
+		p.SetState(242)
+		p.Match(CQLParserT__15)
 	}
 
 	return localctx
@@ -3251,7 +4235,7 @@ func (s *LimitContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CQLParser) Limit() (localctx ILimitContext) {
 	localctx = NewLimitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 44, CQLParserRULE_limit)
+	p.EnterRule(localctx, 54, CQLParserRULE_limit)
 
 	defer func() {
 		p.ExitRule()
@@ -3271,7 +4255,7 @@ func (p *CQLParser) Limit() (localctx ILimitContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(176)
+		p.SetState(244)
 		p.Match(CQLParserINT)
 	}
 
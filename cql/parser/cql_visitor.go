@@ -17,6 +17,15 @@ type CQLVisitor interface {
 	// Visit a parse tree produced by CQLParser#destroy.
 	VisitDestroy(ctx *DestroyContext) interface{}
 
+	// Visit a parse tree produced by CQLParser#alter.
+	VisitAlter(ctx *AlterContext) interface{}
+
+	// Visit a parse tree produced by CQLParser#alterAdd.
+	VisitAlterAdd(ctx *AlterAddContext) interface{}
+
+	// Visit a parse tree produced by CQLParser#alterDrop.
+	VisitAlterDrop(ctx *AlterDropContext) interface{}
+
 	// Visit a parse tree produced by CQLParser#insert.
 	VisitInsert(ctx *InsertContext) interface{}
 
@@ -32,6 +41,9 @@ type CQLVisitor interface {
 	// Visit a parse tree produced by CQLParser#document.
 	VisitDocument(ctx *DocumentContext) interface{}
 
+	// Visit a parse tree produced by CQLParser#namedValue.
+	VisitNamedValue(ctx *NamedValueContext) interface{}
+
 	// Visit a parse tree produced by CQLParser#uintPropDef.
 	VisitUintPropDef(ctx *UintPropDefContext) interface{}
 
@@ -68,6 +80,9 @@ type CQLVisitor interface {
 	// Visit a parse tree produced by CQLParser#strPred.
 	VisitStrPred(ctx *StrPredContext) interface{}
 
+	// Visit a parse tree produced by CQLParser#existPred.
+	VisitExistPred(ctx *ExistPredContext) interface{}
+
 	// Visit a parse tree produced by CQLParser#compare.
 	VisitCompare(ctx *CompareContext) interface{}
 
@@ -0,0 +1,340 @@
+package indexer
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/deepfabric/bkdtree"
+	"github.com/deepfabric/indexer/cql"
+	"github.com/pkg/errors"
+)
+
+const (
+	StoredDocs string = "__storedDocs" // the directory where stores Index.docStore
+
+	// docOffsetSize is the size of an offset record: docID(8) + offset(8) + length(4)
+	docOffsetSize = 20
+)
+
+var (
+	// DocStoreSegmentSizeBytes is the size of a segment data file after which a new segment is started.
+	// It's defined as an exported variable so that tests can set a different segment size.
+	DocStoreSegmentSizeBytes int64 = 64 * 1000 * 1000 // 64MB
+
+	ErrNoDocStore = errors.New("document store is disabled")
+)
+
+// docOffset is the location of a document. A zero length means the document is deleted.
+type docOffset struct {
+	seq    uint64
+	off    int64
+	length uint32
+}
+
+//DocStore stores the original documents of an index. It's append-only.
+//Documents are appended to segment data files, and each segment has an offset file
+//which records the location of every document appended to it.
+type DocStore struct {
+	Dir string
+
+	rwlock  sync.RWMutex        //concurrent access of segs, tail and offsets
+	segs    map[uint64]*os.File //map segment sequence to data file
+	tailSeq uint64
+	tailOff int64
+	tailIdx *os.File //offset file of the tail segment
+	offsets map[uint64]docOffset
+	buf     []byte
+}
+
+//NewDocStore creates and initializes a document store
+func NewDocStore(directory string, overwrite bool) (ds *DocStore, err error) {
+	if overwrite {
+		if err = os.RemoveAll(directory); err != nil {
+			err = errors.Wrap(err, "")
+			return
+		}
+	}
+	ds = &DocStore{
+		Dir: directory,
+		buf: make([]byte, docOffsetSize),
+	}
+	err = ds.Open()
+	return
+}
+
+//Open opens an existing document store
+func (ds *DocStore) Open() (err error) {
+	ds.rwlock.Lock()
+	defer ds.rwlock.Unlock()
+	if ds.segs != nil {
+		//document store is already open
+		return
+	}
+	if err = os.MkdirAll(ds.Dir, 0700); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	var seqList []uint64
+	if seqList, err = getDocSegList(ds.Dir); err != nil {
+		return
+	}
+	if len(seqList) == 0 {
+		seqList = append(seqList, 0)
+	}
+	ds.segs = make(map[uint64]*os.File)
+	ds.offsets = make(map[uint64]docOffset)
+	for i, seq := range seqList {
+		if err = ds.openSegment(seq, i == len(seqList)-1); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func getDocSegList(dir string) (seqList []uint64, err error) {
+	var seq uint64
+	var matches [][]string
+	if matches, err = bkdtree.FilepathGlob(dir, "^(?P<seq>[0-9a-f]{16})\\.dat$"); err != nil {
+		return
+	}
+	for _, match := range matches {
+		if seq, err = strconv.ParseUint(match[1], 16, 64); err != nil {
+			err = errors.Wrap(err, "")
+			return
+		}
+		seqList = append(seqList, seq)
+	}
+	sort.Slice(seqList, func(i, j int) bool { return seqList[i] < seqList[j] })
+	return
+}
+
+func (ds *DocStore) dataPath(seq uint64) string {
+	return filepath.Join(ds.Dir, fmt.Sprintf("%016x.dat", seq))
+}
+
+func (ds *DocStore) idxPath(seq uint64) string {
+	return filepath.Join(ds.Dir, fmt.Sprintf("%016x.idx", seq))
+}
+
+// openSegment opens the data file of the given segment and loads its offset file.
+// Records of the tail segment beyond the data file are treated as torn writes and discarded.
+func (ds *DocStore) openSegment(seq uint64, isTail bool) (err error) {
+	var f *os.File
+	var fi os.FileInfo
+	if f, err = os.OpenFile(ds.dataPath(seq), os.O_CREATE|os.O_RDWR, 0600); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	ds.segs[seq] = f
+	if fi, err = f.Stat(); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	var data []byte
+	if data, err = ioutil.ReadFile(ds.idxPath(seq)); err != nil && !os.IsNotExist(err) {
+		err = errors.Wrap(err, "")
+		return
+	}
+	err = nil
+	validLen := 0
+	for ; validLen+docOffsetSize <= len(data); validLen += docOffsetSize {
+		rec := data[validLen : validLen+docOffsetSize]
+		docID := binary.LittleEndian.Uint64(rec[0:])
+		do := docOffset{
+			seq:    seq,
+			off:    int64(binary.LittleEndian.Uint64(rec[8:])),
+			length: binary.LittleEndian.Uint32(rec[16:]),
+		}
+		if do.off+int64(do.length) > fi.Size() {
+			break
+		}
+		if do.length == 0 {
+			delete(ds.offsets, docID)
+		} else {
+			ds.offsets[docID] = do
+		}
+	}
+	if !isTail {
+		return
+	}
+	if ds.tailIdx, err = os.OpenFile(ds.idxPath(seq), os.O_CREATE|os.O_WRONLY, 0600); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	if err = ds.tailIdx.Truncate(int64(validLen)); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	if _, err = ds.tailIdx.Seek(int64(validLen), io.SeekStart); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	ds.tailSeq = seq
+	ds.tailOff = fi.Size()
+	return
+}
+
+//Close closes all segment files and clears the offsets on memory.
+func (ds *DocStore) Close() (err error) {
+	ds.rwlock.Lock()
+	defer ds.rwlock.Unlock()
+	err = ds.close()
+	return
+}
+
+func (ds *DocStore) close() (err error) {
+	if ds.segs == nil {
+		//document store is already closed
+		return
+	}
+	for _, f := range ds.segs {
+		if err = f.Close(); err != nil {
+			err = errors.Wrap(err, "")
+			return
+		}
+	}
+	if err = ds.tailIdx.Close(); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	ds.segs = nil
+	ds.tailIdx = nil
+	ds.offsets = nil
+	return
+}
+
+//Destroy closes the document store and removes all files on disk.
+func (ds *DocStore) Destroy() (err error) {
+	ds.rwlock.Lock()
+	defer ds.rwlock.Unlock()
+	if err = ds.close(); err != nil {
+		return
+	}
+	if err = os.RemoveAll(ds.Dir); err != nil {
+		err = errors.Wrap(err, "")
+	}
+	return
+}
+
+//Sync synchronizes the tail segment to disk
+func (ds *DocStore) Sync() (err error) {
+	ds.rwlock.Lock()
+	defer ds.rwlock.Unlock()
+	err = ds.sync()
+	return
+}
+
+func (ds *DocStore) sync() (err error) {
+	if err = ds.segs[ds.tailSeq].Sync(); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	if err = ds.tailIdx.Sync(); err != nil {
+		err = errors.Wrap(err, "")
+	}
+	return
+}
+
+// cut synchronizes the tail segment and starts a new one.
+func (ds *DocStore) cut() (err error) {
+	if err = ds.sync(); err != nil {
+		return
+	}
+	if err = ds.tailIdx.Close(); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	ds.tailIdx = nil
+	err = ds.openSegment(ds.tailSeq+1, true)
+	return
+}
+
+//Put appends the given document. It replaces the previous one with the same docID if there is.
+func (ds *DocStore) Put(doc *cql.Document) (err error) {
+	var data []byte
+	if data, err = doc.Marshal(); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	ds.rwlock.Lock()
+	defer ds.rwlock.Unlock()
+	if ds.tailOff >= DocStoreSegmentSizeBytes {
+		if err = ds.cut(); err != nil {
+			return
+		}
+	}
+	if _, err = ds.segs[ds.tailSeq].WriteAt(data, ds.tailOff); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	do := docOffset{
+		seq:    ds.tailSeq,
+		off:    ds.tailOff,
+		length: uint32(len(data)),
+	}
+	if err = ds.writeOffset(doc.DocID, do); err != nil {
+		return
+	}
+	ds.tailOff += int64(len(data))
+	ds.offsets[doc.DocID] = do
+	return
+}
+
+//Del removes the given document. It's allowed that the document doesn't exist.
+func (ds *DocStore) Del(docID uint64) (err error) {
+	ds.rwlock.Lock()
+	defer ds.rwlock.Unlock()
+	if _, found := ds.offsets[docID]; !found {
+		return
+	}
+	if err = ds.writeOffset(docID, docOffset{seq: ds.tailSeq, off: ds.tailOff}); err != nil {
+		return
+	}
+	delete(ds.offsets, docID)
+	return
+}
+
+func (ds *DocStore) writeOffset(docID uint64, do docOffset) (err error) {
+	binary.LittleEndian.PutUint64(ds.buf[0:], docID)
+	binary.LittleEndian.PutUint64(ds.buf[8:], uint64(do.off))
+	binary.LittleEndian.PutUint32(ds.buf[16:], do.length)
+	if _, err = ds.tailIdx.Write(ds.buf); err != nil {
+		err = errors.Wrap(err, "")
+	}
+	return
+}
+
+//Get returns the document of the given docID.
+func (ds *DocStore) Get(docID uint64) (doc *cql.Document, found bool, err error) {
+	var do docOffset
+	ds.rwlock.RLock()
+	defer ds.rwlock.RUnlock()
+	if do, found = ds.offsets[docID]; !found {
+		return
+	}
+	data := make([]byte, do.length)
+	if _, err = ds.segs[do.seq].ReadAt(data, do.off); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	doc = &cql.Document{}
+	if err = doc.Unmarshal(data); err != nil {
+		err = errors.Wrap(err, "")
+	}
+	return
+}
+
+//Count returns the count of documents
+func (ds *DocStore) Count() (cnt uint64) {
+	ds.rwlock.RLock()
+	cnt = uint64(len(ds.offsets))
+	ds.rwlock.RUnlock()
+	return
+}
@@ -0,0 +1,74 @@
+package indexer
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/deepfabric/indexer/cql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocStore(t *testing.T) {
+	var err error
+	var ds, ds2 *DocStore
+	var doc *cql.Document
+	var found bool
+	numDocs := 1000
+
+	//use small segments to cover segment rolling
+	oldSegSize := DocStoreSegmentSizeBytes
+	DocStoreSegmentSizeBytes = 4096
+	defer func() { DocStoreSegmentSizeBytes = oldSegSize }()
+
+	//TESTCASE: put and get documents of an empty store
+	ds, err = NewDocStore("/tmp/doc_store_test", true)
+	require.NoError(t, err)
+	for i := 0; i < numDocs; i++ {
+		docProt := newDocProt()
+		docProt.Doc.DocID = uint64(i)
+		for j := 0; j < len(docProt.Doc.UintProps); j++ {
+			docProt.Doc.UintProps[j].Val = uint64(i * (j + 1))
+		}
+		for j := 0; j < len(docProt.Doc.StrProps); j++ {
+			docProt.Doc.StrProps[j].Val = fmt.Sprintf("%03d%03d and some random text", i, j)
+		}
+		err = ds.Put(&docProt.Doc)
+		require.NoError(t, err)
+	}
+	require.Equal(t, uint64(numDocs), ds.Count())
+	doc, found, err = ds.Get(7)
+	require.NoError(t, err)
+	require.Equal(t, true, found)
+	require.Equal(t, uint64(7*2), doc.UintProps[1].Val)
+	require.Equal(t, "007001 and some random text", doc.StrProps[1].Val)
+
+	//TESTCASE: delete and replace documents
+	for i := 0; i < numDocs; i += 2 {
+		err = ds.Del(uint64(i))
+		require.NoError(t, err)
+	}
+	docProt := newDocProt()
+	docProt.Doc.DocID = 1
+	docProt.Doc.StrProps[0].Val = "replaced"
+	err = ds.Put(&docProt.Doc)
+	require.NoError(t, err)
+	err = ds.Close()
+	require.NoError(t, err)
+
+	//TESTCASE: reopen an existing store
+	ds2, err = NewDocStore("/tmp/doc_store_test", false)
+	require.NoError(t, err)
+	defer ds2.Close()
+	require.Equal(t, uint64(numDocs/2), ds2.Count())
+	_, found, err = ds2.Get(0)
+	require.NoError(t, err)
+	require.Equal(t, false, found)
+	doc, found, err = ds2.Get(1)
+	require.NoError(t, err)
+	require.Equal(t, true, found)
+	require.Equal(t, "replaced", doc.StrProps[0].Val)
+	doc, found, err = ds2.Get(uint64(numDocs - 1))
+	require.NoError(t, err)
+	require.Equal(t, true, found)
+	require.Equal(t, uint64(numDocs-1), doc.DocID)
+}
@@ -18,3 +18,10 @@ for dir in ${DIRS}; do
 		rm -f *.bak
 	popd
 done
+
+# rpc.proto imports cql/doc.proto by its full import path, and defines a gRPC service.
+pushd ./rpc/rpcpb
+	protoc --gofast_out=plugins=grpc:. -I=.:"${GOGOPROTO_PATH}":"${GOPATH}/src" *.proto
+	sed -i.bak -E 's/import _ \"gogoproto\"//g' *.pb.go
+	rm -f *.bak
+popd
@@ -0,0 +1,152 @@
+package indexer
+
+import (
+	"bytes"
+	"unicode/utf8"
+
+	"github.com/deepfabric/bkdtree"
+	"github.com/deepfabric/indexer/cql"
+	"github.com/pkg/errors"
+)
+
+var (
+	// HighlightPreTag and HighlightPostTag surround every matched term in a fragment.
+	HighlightPreTag  = "<em>"
+	HighlightPostTag = "</em>"
+	// HighlightFragmentSize is the approximate size in bytes of a fragment.
+	HighlightFragmentSize = 100
+	// HighlightMaxFragments is the maximum number of fragments of a property of a document.
+	HighlightMaxFragments = 3
+)
+
+//Highlight populates qr.Hl with fragments of the StrProps which q.StrPreds match.
+//The hits are the ordered ones if q.OrderBy is given, otherwise the first q.Limit documents of qr.Bm.
+func (ind *Index) Highlight(q *cql.CqlSelect, qr *QueryResult) (err error) {
+	if len(q.StrPreds) == 0 {
+		return
+	}
+	terms := make(map[string]map[string]bool)
+	for _, strPred := range q.StrPreds {
+		words := ParseWords(strPred.ContWord)
+		set := make(map[string]bool, len(words))
+		for _, word := range words {
+			set[word] = true
+		}
+		terms[strPred.Name] = set
+	}
+
+	var docIDs []uint64
+	if q.OrderBy != "" {
+		for _, item := range qr.Oa.Finalize() {
+			docIDs = append(docIDs, item.(bkdtree.Point).UserData)
+		}
+	} else {
+		limit := q.Limit
+		if limit <= 0 {
+			limit = cql.DEFAULT_LIMIT
+		}
+		docIDs = qr.Bm.Bits()
+		if len(docIDs) > limit {
+			docIDs = docIDs[:limit]
+		}
+	}
+
+	ind.rwlock.RLock()
+	defer ind.rwlock.RUnlock()
+	if ind.docStore == nil {
+		err = errors.Wrapf(ErrNoDocStore, "index %v doesn't store documents", ind.DocProt.Index)
+		return
+	}
+	qr.Hl = make(map[uint64]map[string][]string)
+	var doc *cql.Document
+	var found bool
+	for _, docID := range docIDs {
+		if doc, found, err = ind.docStore.Get(docID); err != nil {
+			return
+		} else if !found {
+			continue
+		}
+		frags := make(map[string][]string)
+		//the stored document is the original one, absent properties are indexed with the defaults
+		for _, strProp := range ind.withDefaults(doc).StrProps {
+			set, ok := terms[strProp.Name]
+			if !ok {
+				continue
+			}
+			if fs := highlightText(strProp.Val, set, HighlightFragmentSize, HighlightMaxFragments); len(fs) != 0 {
+				frags[strProp.Name] = fs
+			}
+		}
+		qr.Hl[docID] = frags
+	}
+	return
+}
+
+//highlightText returns at most maxFrags fragments of text. Each fragment is about fragSize bytes, and
+//every occurrence of the given terms inside it is surrounded by HighlightPreTag and HighlightPostTag.
+//It uses the same tokenizer as TextFrame.DoIndex so that the marks line up with the indexed words.
+func highlightText(text string, terms map[string]bool, fragSize, maxFrags int) (frags []string) {
+	words, offsets := ParseWordsWithOffsets(text)
+	hits := make([]WordOffset, 0)
+	for i, word := range words {
+		if terms[word] {
+			hits = append(hits, offsets[i])
+		}
+	}
+	prevEnd := 0
+	for i := 0; i < len(hits) && len(frags) < maxFrags; {
+		// leave some context before the first hit of the fragment
+		start := hits[i].Start - fragSize/4
+		if start < prevEnd {
+			start = prevEnd
+		}
+		start = runeStartAfter(text, start)
+		end := start + fragSize
+		if end < hits[i].End {
+			end = hits[i].End
+		}
+		end = runeStartBefore(text, end)
+		// never cut a hit in two, otherwise the next fragment would start inside it
+		for j := i; j < len(hits) && hits[j].Start < end; j++ {
+			if hits[j].End > end {
+				end = hits[j].End
+			}
+		}
+
+		var buf bytes.Buffer
+		pos := start
+		for ; i < len(hits) && hits[i].End <= end; i++ {
+			buf.WriteString(text[pos:hits[i].Start])
+			buf.WriteString(HighlightPreTag)
+			buf.WriteString(text[hits[i].Start:hits[i].End])
+			buf.WriteString(HighlightPostTag)
+			pos = hits[i].End
+		}
+		buf.WriteString(text[pos:end])
+		frags = append(frags, buf.String())
+		prevEnd = end
+	}
+	return
+}
+
+//runeStartAfter returns the first rune boundary of text at or after off.
+func runeStartAfter(text string, off int) int {
+	if off <= 0 {
+		return 0
+	}
+	for off < len(text) && !utf8.RuneStart(text[off]) {
+		off++
+	}
+	return off
+}
+
+//runeStartBefore returns the last rune boundary of text at or before off.
+func runeStartBefore(text string, off int) int {
+	if off >= len(text) {
+		return len(text)
+	}
+	for off > 0 && !utf8.RuneStart(text[off]) {
+		off--
+	}
+	return off
+}
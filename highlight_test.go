@@ -0,0 +1,129 @@
+package indexer
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/deepfabric/indexer/cql"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWordsWithOffsets(t *testing.T) {
+	text := "Go's standard library, 索引是trigram倒排表。"
+	words, offsets := ParseWordsWithOffsets(text)
+	require.Equal(t, ParseWords(text), words)
+	require.Equal(t, len(words), len(offsets))
+	for i, word := range words {
+		require.Equal(t, word, strings.ToLower(text[offsets[i].Start:offsets[i].End]))
+	}
+}
+
+func TestHighlightText(t *testing.T) {
+	var frags []string
+	terms := map[string]bool{"library": true, "索": true}
+
+	//TESTCASE: all hits fit in one fragment
+	frags = highlightText("Go's standard Library, 索引是trigram倒排表。", terms, 100, 3)
+	require.Equal(t, []string{"Go's standard <em>Library</em>, <em>索</em>引是trigram倒排表。"}, frags)
+
+	//TESTCASE: no hit
+	frags = highlightText("nothing matches here", terms, 100, 3)
+	require.Equal(t, 0, len(frags))
+
+	//TESTCASE: hits are split into fragments, and the count of fragments is limited
+	text := strings.Repeat("some filler words library ", 20)
+	frags = highlightText(text, terms, 40, 3)
+	require.Equal(t, 3, len(frags))
+	for _, frag := range frags {
+		require.Contains(t, frag, "<em>library</em>")
+	}
+
+	//TESTCASE: a hit crossing the fragment boundary extends the fragment
+	frags = highlightText(strings.Repeat("library ", 5), terms, 10, 3)
+	require.Equal(t, []string{"<em>library</em> <em>library</em>", " <em>library</em> <em>library</em>", " <em>library</em> "}, frags)
+
+	//TESTCASE: fragment boundaries never split a multi-byte rune
+	text = strings.Repeat("倒排表", 30) + "索" + strings.Repeat("倒排表", 30)
+	frags = highlightText(text, terms, 20, 3)
+	require.Equal(t, 1, len(frags))
+	require.Contains(t, frags[0], "<em>索</em>")
+	for _, r := range frags[0] {
+		require.NotEqual(t, '�', r)
+	}
+}
+
+func TestIndexerHighlight(t *testing.T) {
+	var err error
+	var ir *Indexer
+	var qr *QueryResult
+	initialNumDocs := 37
+
+	ir, err = NewIndexer("/tmp/indexer_test", true, false)
+	require.NoError(t, err)
+	docProt := newDocProt1()
+	docProt.StoreDoc = true
+	err = ir.CreateIndex(docProt)
+	require.NoError(t, err)
+	err = ir.CreateIndex(newDocProt2())
+	require.NoError(t, err)
+	for i := 0; i < initialNumDocs; i++ {
+		doc := newDocProt1()
+		doc.Doc.DocID = uint64(i)
+		for j := 0; j < len(doc.Doc.UintProps); j++ {
+			doc.Doc.UintProps[j].Val = uint64(i * (j + 1))
+		}
+		doc.Doc.StrProps[0].Val = fmt.Sprintf("order %03d of some random text", i)
+		err = ir.Insert(doc)
+		require.NoError(t, err)
+	}
+
+	//TESTCASE: highlight without OrderBy
+	cs := &cql.CqlSelect{
+		Index: "orders",
+		StrPreds: map[string]cql.StrPred{
+			"description": cql.StrPred{
+				Name:     "description",
+				ContWord: "random",
+			},
+		},
+		Limit:     10,
+		Highlight: true,
+	}
+	qr, err = ir.Select(cs)
+	require.NoError(t, err)
+	require.Equal(t, 10, len(qr.Hl))
+	require.Equal(t, []string{"order 003 of some <em>random</em> text"}, qr.Hl[3]["description"])
+
+	//TESTCASE: highlight with OrderBy
+	cs.UintPreds = map[string]cql.UintPred{
+		"price": cql.UintPred{
+			Name: "price",
+			Low:  10,
+			High: 20,
+		},
+	}
+	cs.OrderBy = "price"
+	qr, err = ir.Select(cs)
+	require.NoError(t, err)
+	require.Equal(t, 6, len(qr.Hl))
+	require.Equal(t, []string{"order 005 of some <em>random</em> text"}, qr.Hl[5]["description"])
+
+	//TESTCASE: highlight an index which doesn't store documents shall fail
+	cs = &cql.CqlSelect{
+		Index: "addrs",
+		StrPreds: map[string]cql.StrPred{
+			"description": cql.StrPred{
+				Name:     "description",
+				ContWord: "random",
+			},
+		},
+		Highlight: true,
+	}
+	_, err = ir.Select(cs)
+	require.Equal(t, ErrNoDocStore, errors.Cause(err))
+
+	err = ir.Close()
+	require.NoError(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
 	"sync"
 
 	"github.com/deepfabric/bkdtree"
@@ -14,11 +15,13 @@ import (
 )
 
 const (
-	LiveDocs string = "__liveDocs" // the directory where stores Index.liveDocs
+	LiveDocs   string = "__liveDocs"   // the directory where stores Index.liveDocs
+	ExistProps string = "__existProps" // the directory where stores Index.existProps
 )
 
 var (
 	ErrUnknownProp = errors.New("unknown property")
+	ErrPropExist   = errors.New("property already exist")
 	ErrDocExist    = errors.New("document already exist")
 )
 
@@ -26,24 +29,35 @@ var (
 type Index struct {
 	MainDir string
 	DocProt *cql.DocumentWithIdx //document prototype. persisted to an index-specific file
+	//Parallelism is the max number of slices Select evaluates concurrently. 0 means runtime.GOMAXPROCS(0).
+	Parallelism int
 
-	rwlock    sync.RWMutex //concurrent access of frames, liveDocs
-	intFrames map[string]*IntFrame
-	txtFrames map[string]*TextFrame
-	liveDocs  *TextFrame //row 0 of this frame stores a bitmap of live docIDs. other rows are not used.
-	dirty     bool
+	rwlock     sync.RWMutex //concurrent access of frames, liveDocs
+	intFrames  map[string]*IntFrame
+	txtFrames  map[string]*TextFrame
+	liveDocs   *TextFrame //row 0 of this frame stores a bitmap of live docIDs. other rows are not used.
+	existProps *TextFrame //each row stores a bitmap of docIDs which have the property. the row ID is the term ID of the property name.
+	docStore   *DocStore  //original documents. nil if DocProt.StoreDoc is false.
+	dirty      bool
 }
 
 // QueryResult is query result
 type QueryResult struct {
-	Bm *pilosa.Bitmap               // used when no OrderBy given
-	Oa *datastructures.OrderedArray // used when OrderBy given
+	Bm *pilosa.Bitmap                 // used when no OrderBy given
+	Oa *datastructures.OrderedArray   // used when OrderBy given
+	Hl map[uint64]map[string][]string // highlighted fragments, map docID to property to fragments. used when Highlight given
 }
 
 // Merge merges other (keep unchagned) into qr
 func (qr *QueryResult) Merge(other *QueryResult) {
 	qr.Bm.Merge(other.Bm)
 	qr.Oa.Merge(other.Oa)
+	for docID, frags := range other.Hl {
+		if qr.Hl == nil {
+			qr.Hl = make(map[uint64]map[string][]string)
+		}
+		qr.Hl[docID] = frags
+	}
 }
 
 // NewQueryResult creates an empty QueryResult
@@ -92,6 +106,17 @@ func NewIndex(docProt *cql.DocumentWithIdx, mainDir string) (ind *Index, err err
 		return
 	}
 	ind.liveDocs = tfm
+	dir = filepath.Join(indDir, ExistProps)
+	if tfm, err = NewTextFrame(dir, docProt.Index, ExistProps, true); err != nil {
+		return
+	}
+	ind.existProps = tfm
+	if docProt.StoreDoc {
+		dir = filepath.Join(indDir, StoredDocs)
+		if ind.docStore, err = NewDocStore(dir, true); err != nil {
+			return
+		}
+	}
 	return
 }
 
@@ -131,9 +156,19 @@ func (ind *Index) Destroy() (err error) {
 		if err = ind.liveDocs.Destroy(); err != nil {
 			return
 		}
+		if err = ind.existProps.Destroy(); err != nil {
+			return
+		}
+		if ind.docStore != nil {
+			if err = ind.docStore.Destroy(); err != nil {
+				return
+			}
+		}
 		ind.intFrames = nil
 		ind.txtFrames = nil
 		ind.liveDocs = nil
+		ind.existProps = nil
+		ind.docStore = nil
 	}
 
 	paths := make([]string, 0)
@@ -144,6 +179,8 @@ func (ind *Index) Destroy() (err error) {
 		paths = append(paths, filepath.Join(ind.MainDir, strProp.Name))
 	}
 	paths = append(paths, filepath.Join(ind.MainDir, LiveDocs))
+	paths = append(paths, filepath.Join(ind.MainDir, ExistProps))
+	paths = append(paths, filepath.Join(ind.MainDir, StoredDocs))
 	paths = append(paths, filepath.Join(ind.MainDir, fmt.Sprintf("index_%s.json", ind.DocProt.Index)))
 	for _, fp := range paths {
 		if err = os.RemoveAll(fp); err != nil {
@@ -200,7 +237,126 @@ func (ind *Index) Open() (err error) {
 		return
 	}
 	ind.liveDocs = tfm
+	dir = filepath.Join(indDir, ExistProps)
+	//an index created before the existence of properties was recorded doesn't have the frame
+	_, statErr := os.Stat(dir)
+	if tfm, err = NewTextFrame(dir, ind.DocProt.Index, ExistProps, false); err != nil {
+		return
+	}
+	ind.existProps = tfm
+	if ind.DocProt.StoreDoc {
+		dir = filepath.Join(indDir, StoredDocs)
+		if ind.docStore, err = NewDocStore(dir, false); err != nil {
+			return
+		}
+	}
 	ind.dirty = false
+	if os.IsNotExist(statErr) {
+		if err = ind.backfillExist(); err != nil {
+			return
+		}
+		ind.dirty = true
+	}
+	return
+}
+
+//backfillExist records that every live document has every property. It's for an index created before the existence
+//of properties was recorded, when a document had to be inserted with all properties.
+func (ind *Index) backfillExist() (err error) {
+	docIDs := ind.liveDocs.row(0).Bits()
+	for _, name := range ind.propNames() {
+		for _, docID := range docIDs {
+			if err = ind.setExist(docID, name); err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+//Alter changes the schema to newDocProt. It creates frames of the added properties and destroys frames of the dropped ones.
+//Frames of the other properties are kept intact. The caller shall ensure the properties kept have the same types.
+func (ind *Index) Alter(newDocProt *cql.DocumentWithIdx) (err error) {
+	ind.rwlock.Lock()
+	defer ind.rwlock.Unlock()
+	indDir := filepath.Join(ind.MainDir, newDocProt.Index)
+	uintNames := make(map[string]bool)
+	var ifm *IntFrame
+	for _, uintProp := range newDocProt.Doc.UintProps {
+		uintNames[uintProp.Name] = true
+		if _, ok := ind.intFrames[uintProp.Name]; ok {
+			continue
+		}
+		dir := filepath.Join(indDir, uintProp.Name)
+		if ifm, err = NewIntFrame(dir, newDocProt.Index, uintProp.Name, uint(uintProp.ValLen*8), true); err != nil {
+			return
+		}
+		ind.intFrames[uintProp.Name] = ifm
+	}
+	for name, ifm := range ind.intFrames {
+		if uintNames[name] {
+			continue
+		}
+		if err = ifm.Destroy(); err != nil {
+			return
+		}
+		if err = os.RemoveAll(filepath.Join(indDir, name)); err != nil {
+			err = errors.Wrap(err, "")
+			return
+		}
+		delete(ind.intFrames, name)
+	}
+	strNames := make(map[string]bool)
+	var tfm *TextFrame
+	for _, strProp := range newDocProt.Doc.StrProps {
+		strNames[strProp.Name] = true
+		if _, ok := ind.txtFrames[strProp.Name]; ok {
+			continue
+		}
+		dir := filepath.Join(indDir, strProp.Name)
+		if tfm, err = NewTextFrame(dir, newDocProt.Index, strProp.Name, true); err != nil {
+			return
+		}
+		ind.txtFrames[strProp.Name] = tfm
+	}
+	for name, tfm := range ind.txtFrames {
+		if strNames[name] {
+			continue
+		}
+		if err = tfm.Destroy(); err != nil {
+			return
+		}
+		if err = os.RemoveAll(filepath.Join(indDir, name)); err != nil {
+			err = errors.Wrap(err, "")
+			return
+		}
+		delete(ind.txtFrames, name)
+	}
+	for _, uintProp := range ind.DocProt.Doc.UintProps {
+		if !hasProp(newDocProt, uintProp.Name) {
+			if err = ind.clearExist(uintProp.Name); err != nil {
+				return
+			}
+		}
+	}
+	for _, enumProp := range ind.DocProt.Doc.EnumProps {
+		if !hasProp(newDocProt, enumProp.Name) {
+			if err = ind.clearExist(enumProp.Name); err != nil {
+				return
+			}
+		}
+	}
+	for _, strProp := range ind.DocProt.Doc.StrProps {
+		if !hasProp(newDocProt, strProp.Name) {
+			if err = ind.clearExist(strProp.Name); err != nil {
+				return
+			}
+		}
+	}
+	if err = indexWriteConf(ind.MainDir, newDocProt); err != nil {
+		return
+	}
+	ind.DocProt = newDocProt
 	return
 }
 
@@ -225,9 +381,19 @@ func (ind *Index) Close() (err error) {
 	if err = ind.liveDocs.Close(); err != nil {
 		return
 	}
+	if err = ind.existProps.Close(); err != nil {
+		return
+	}
+	if ind.docStore != nil {
+		if err = ind.docStore.Close(); err != nil {
+			return
+		}
+	}
 	ind.intFrames = nil
 	ind.txtFrames = nil
 	ind.liveDocs = nil
+	ind.existProps = nil
+	ind.docStore = nil
 	ind.dirty = false
 	return
 }
@@ -252,11 +418,42 @@ func (ind *Index) Sync() (err error) {
 	if err = ind.liveDocs.Sync(); err != nil {
 		return
 	}
+	if err = ind.existProps.Sync(); err != nil {
+		return
+	}
+	if ind.docStore != nil {
+		if err = ind.docStore.Sync(); err != nil {
+			return
+		}
+	}
 	ind.dirty = false
 	return
 }
 
-//Insert executes CqlInsert
+//linkFragments hard-links the fragment files of all frames into the index directory under dstMainDir.
+func (ind *Index) linkFragments(dstMainDir string) (err error) {
+	ind.rwlock.RLock()
+	defer ind.rwlock.RUnlock()
+	indDir := filepath.Join(dstMainDir, ind.DocProt.Index)
+	for name, ifm := range ind.intFrames {
+		if err = ifm.linkFragments(filepath.Join(indDir, name)); err != nil {
+			return
+		}
+	}
+	for name, tfm := range ind.txtFrames {
+		if err = tfm.linkFragments(filepath.Join(indDir, name)); err != nil {
+			return
+		}
+	}
+	if err = ind.liveDocs.linkFragments(filepath.Join(indDir, LiveDocs)); err != nil {
+		return
+	}
+	err = ind.existProps.linkFragments(filepath.Join(indDir, ExistProps))
+	return
+}
+
+//Insert executes CqlInsert. Properties absent from doc are set to their default values if there are.
+//The document is stored as given, without the default values.
 func (ind *Index) Insert(doc *cql.DocumentWithIdx) (err error) {
 	var ifm *IntFrame
 	var tfm *TextFrame
@@ -270,21 +467,39 @@ func (ind *Index) Insert(doc *cql.DocumentWithIdx) (err error) {
 		err = errors.Wrapf(ErrDocExist, "document %v is alaredy there before insertion", doc.Doc.DocID)
 		return
 	}
-	for _, uintProp := range doc.Doc.UintProps {
+	d := ind.withDefaults(&doc.Doc)
+	for _, uintProp := range d.UintProps {
 		if ifm, ok = ind.intFrames[uintProp.Name]; !ok {
 			err = errors.Wrapf(ErrUnknownProp, "property %v is missing at index spec, document %v, index spec %v", uintProp.Name, doc, ind.DocProt)
 			return
 		}
-		if err = ifm.DoIndex(doc.Doc.DocID, uintProp.Val); err != nil {
+		if err = ifm.DoIndex(d.DocID, uintProp.Val); err != nil {
+			return
+		}
+		if err = ind.setExist(d.DocID, uintProp.Name); err != nil {
+			return
+		}
+	}
+	for _, enumProp := range d.EnumProps {
+		if err = ind.setExist(d.DocID, enumProp.Name); err != nil {
 			return
 		}
 	}
-	for _, strProp := range doc.Doc.StrProps {
+	for _, strProp := range d.StrProps {
 		if tfm, ok = ind.txtFrames[strProp.Name]; !ok {
 			err = errors.Wrapf(ErrUnknownProp, "property %v is missing at index spec, document %v, index spec %v", strProp.Name, doc, ind.DocProt)
 			return
 		}
-		if err = tfm.DoIndex(doc.Doc.DocID, strProp.Val); err != nil {
+		if err = tfm.DoIndex(d.DocID, strProp.Val); err != nil {
+			return
+		}
+		if err = ind.setExist(d.DocID, strProp.Name); err != nil {
+			return
+		}
+	}
+	if ind.docStore != nil {
+		//store the document as given, the defaults are applied again on reading it
+		if err = ind.docStore.Put(&doc.Doc); err != nil {
 			return
 		}
 	}
@@ -292,6 +507,106 @@ func (ind *Index) Insert(doc *cql.DocumentWithIdx) (err error) {
 	return
 }
 
+//withDefaults returns doc with the absent properties set to their default values. doc itself is kept unchanged.
+func (ind *Index) withDefaults(doc *cql.Document) (filled *cql.Document) {
+	defaults := &ind.DocProt.Defaults
+	filled = doc
+	if len(defaults.UintProps)+len(defaults.EnumProps)+len(defaults.StrProps) == 0 {
+		return
+	}
+	present := make(map[string]bool)
+	for _, uintProp := range doc.UintProps {
+		present[uintProp.Name] = true
+	}
+	for _, enumProp := range doc.EnumProps {
+		present[enumProp.Name] = true
+	}
+	for _, strProp := range doc.StrProps {
+		present[strProp.Name] = true
+	}
+	copyOnce := func() {
+		if filled != doc {
+			return
+		}
+		cp := *doc
+		cp.UintProps = append([]*cql.UintProp(nil), doc.UintProps...)
+		cp.EnumProps = append([]*cql.EnumProp(nil), doc.EnumProps...)
+		cp.StrProps = append([]*cql.StrProp(nil), doc.StrProps...)
+		filled = &cp
+	}
+	for _, uintProp := range defaults.UintProps {
+		if !present[uintProp.Name] {
+			copyOnce()
+			filled.UintProps = append(filled.UintProps, uintProp)
+		}
+	}
+	for _, enumProp := range defaults.EnumProps {
+		if !present[enumProp.Name] {
+			copyOnce()
+			filled.EnumProps = append(filled.EnumProps, enumProp)
+		}
+	}
+	for _, strProp := range defaults.StrProps {
+		if !present[strProp.Name] {
+			copyOnce()
+			filled.StrProps = append(filled.StrProps, strProp)
+		}
+	}
+	return
+}
+
+//setExist records that the given document has the given property.
+func (ind *Index) setExist(docID uint64, name string) (err error) {
+	var termID uint64
+	if termID, err = ind.existProps.td.CreateTermIfNotExist(name); err != nil {
+		return
+	}
+	_, err = ind.existProps.setBit(termID, docID)
+	return
+}
+
+//clearExist forgets the existence of the given property for all documents.
+func (ind *Index) clearExist(name string) (err error) {
+	termID, found := ind.existProps.td.GetTermID(name)
+	if !found {
+		return
+	}
+	for _, docID := range ind.existProps.row(termID).Bits() {
+		if _, err = ind.existProps.clearBit(termID, docID); err != nil {
+			return
+		}
+	}
+	return
+}
+
+//propNames returns the names of all properties of the schema.
+func (ind *Index) propNames() (names []string) {
+	for _, uintProp := range ind.DocProt.Doc.UintProps {
+		names = append(names, uintProp.Name)
+	}
+	for _, enumProp := range ind.DocProt.Doc.EnumProps {
+		names = append(names, enumProp.Name)
+	}
+	for _, strProp := range ind.DocProt.Doc.StrProps {
+		names = append(names, strProp.Name)
+	}
+	return
+}
+
+//clearDocExist forgets the existence of all properties for the given document.
+func (ind *Index) clearDocExist(docID uint64) (err error) {
+	for _, name := range ind.propNames() {
+		termID, found := ind.existProps.td.GetTermID(name)
+		if !found {
+			continue
+		}
+		if _, err = ind.existProps.clearBit(termID, docID); err != nil {
+			return
+		}
+	}
+	return
+}
+
 //Del executes CqlDel. Do mark-deletion only. The caller shall rebuild index in order to recycle disk space.
 func (ind *Index) Del(docID uint64) (found bool, err error) {
 	var changed bool
@@ -303,88 +618,298 @@ func (ind *Index) Del(docID uint64) (found bool, err error) {
 		return
 	}
 	found = true
+	if err = ind.clearDocExist(docID); err != nil {
+		return
+	}
+	if ind.docStore != nil {
+		if err = ind.docStore.Del(docID); err != nil {
+			return
+		}
+	}
 	ind.dirty = true
 	return
 }
 
-//Select executes CqlSelect.
-func (ind *Index) Select(q *cql.CqlSelect) (qr *QueryResult, err error) {
-	qr = &QueryResult{
-		Bm: pilosa.NewBitmap(),
-		Oa: datastructures.NewOrderedArray(q.Limit),
-	}
-	var ifm *IntFrame
-	var tfm *TextFrame
-	var ok bool
-	var prevDocs, docs *pilosa.Bitmap
-
+//clearDocTerms clears the text term bits of the given document if it's live. The old document is taken from the
+//document store, or is old if it isn't stored. It fails with ErrNoDocStore if the old document is unknown.
+func (ind *Index) clearDocTerms(docID uint64, old *cql.Document) (err error) {
 	ind.rwlock.RLock()
 	defer ind.rwlock.RUnlock()
-	prevDocs = ind.liveDocs.row(0)
-	if prevDocs.Count() == 0 {
+	if len(ind.txtFrames) == 0 || !ind.liveDocs.hasBit(0, docID) {
 		return
 	}
-	if len(q.StrPreds) != 0 {
-		for _, strPred := range q.StrPreds {
-			if tfm, ok = ind.txtFrames[strPred.Name]; !ok {
-				err = errors.Wrapf(ErrUnknownProp, "property %s not found in index spec", strPred.Name)
-				return
-			}
-			docs = tfm.Query(strPred.ContWord)
-			prevDocs = prevDocs.Intersect(docs)
-			if prevDocs.Count() == 0 {
+	if ind.docStore != nil {
+		var stored *cql.Document
+		var found bool
+		if stored, found, err = ind.docStore.Get(docID); err != nil {
+			return
+		} else if found {
+			old = stored
+		}
+	}
+	if old == nil {
+		err = errors.Wrapf(ErrNoDocStore, "index %v doesn't store documents, the text terms of document %v are unknown", ind.DocProt.Index, docID)
+		return
+	}
+	for _, strProp := range ind.withDefaults(old).StrProps {
+		if tfm, ok := ind.txtFrames[strProp.Name]; ok {
+			if err = tfm.clearDoc(docID, strProp.Val); err != nil {
 				return
 			}
 		}
 	}
+	return
+}
+
+//Update replaces the document of the same DocID, or inserts it if there's none.
+//The text terms and the property existence of the old document are cleared before the insertion.
+//The text terms are taken from the stored document, so replacing a document of an index which has STRING properties
+//but doesn't store documents fails with ErrNoDocStore.
+func (ind *Index) Update(doc *cql.DocumentWithIdx) (err error) {
+	err = ind.update(doc, nil)
+	return
+}
 
-	if len(q.UintPreds) == 0 {
-		qr.Bm = prevDocs
+//update is Update whose old document is old if it isn't stored. old is nil if unknown.
+func (ind *Index) update(doc *cql.DocumentWithIdx, old *cql.Document) (err error) {
+	if err = ind.clearDocTerms(doc.Doc.DocID, old); err != nil {
 		return
 	}
+	if _, err = ind.Del(doc.Doc.DocID); err != nil {
+		return
+	}
+	err = ind.Insert(doc)
+	return
+}
 
-	var ifmOrder *IntFrame
+//Get returns the original document of the given docID. It requires DocProt.StoreDoc is set.
+func (ind *Index) Get(docID uint64) (doc *cql.Document, found bool, err error) {
+	ind.rwlock.RLock()
+	defer ind.rwlock.RUnlock()
+	if ind.docStore == nil {
+		err = errors.Wrapf(ErrNoDocStore, "index %v doesn't store documents", ind.DocProt.Index)
+		return
+	}
+	doc, found, err = ind.docStore.Get(docID)
+	return
+}
+
+//Select executes CqlSelect. The predicates are evaluated slice by slice in parallel, and the per-slice results are merged.
+func (ind *Index) Select(q *cql.CqlSelect) (qr *QueryResult, err error) {
+	qr = NewQueryResult(q.Limit)
+	ind.rwlock.RLock()
+	defer ind.rwlock.RUnlock()
+	var plan *selectPlan
+	if plan, err = ind.planSelect(q); err != nil || plan == nil {
+		return
+	}
+	var mu sync.Mutex
+	err = forEachSlice(ind.liveDocs.GetFragList(), ind.Parallelism, func(slice uint64) (err error) {
+		var sqr *QueryResult
+		if sqr, err = plan.selectSlice(slice, q.Limit); err != nil {
+			return
+		}
+		mu.Lock()
+		qr.Merge(sqr)
+		mu.Unlock()
+		return
+	})
+	return
+}
+
+//selectPlan is a CqlSelect whose properties and terms are resolved, so that it can be evaluated on each slice independently.
+type selectPlan struct {
+	liveDocs   *TextFrame
+	existProps *TextFrame
+	strPreds   []strPredPlan
+	existPreds []existPredPlan
+	uintPreds  []uintPredPlan
+	orderBy    *IntFrame //nil if OrderBy is not given or not one of UintPreds
+}
+
+type strPredPlan struct {
+	tfm     *TextFrame
+	termIDs []uint64
+}
+
+type existPredPlan struct {
+	termID uint64
+	found  bool //whether any document has ever had the property
+	exists bool
+}
+
+type uintPredPlan struct {
+	ifm       *IntFrame
+	low, high uint64
+}
+
+//planSelect resolves the properties and terms of q. plan is nil if nothing could match. ind.rwlock shall be held.
+func (ind *Index) planSelect(q *cql.CqlSelect) (plan *selectPlan, err error) {
+	p := &selectPlan{liveDocs: ind.liveDocs, existProps: ind.existProps}
+	for _, strPred := range q.StrPreds {
+		tfm, ok := ind.txtFrames[strPred.Name]
+		if !ok {
+			err = errors.Wrapf(ErrUnknownProp, "property %s not found in index spec", strPred.Name)
+			return
+		}
+		termIDs, found := tfm.termIDs(strPred.ContWord)
+		if !found {
+			return
+		}
+		p.strPreds = append(p.strPreds, strPredPlan{tfm: tfm, termIDs: termIDs})
+	}
+	for _, existPred := range q.ExistPreds {
+		if !hasProp(ind.DocProt, existPred.Name) {
+			err = errors.Wrapf(ErrUnknownProp, "property %s not found in index spec", existPred.Name)
+			return
+		}
+		termID, found := ind.existProps.td.GetTermID(existPred.Name)
+		if !found && existPred.Exists {
+			return
+		}
+		p.existPreds = append(p.existPreds, existPredPlan{termID: termID, found: found, exists: existPred.Exists})
+	}
 	for _, uintPred := range q.UintPreds {
-		if ifm, ok = ind.intFrames[uintPred.Name]; !ok {
+		ifm, ok := ind.intFrames[uintPred.Name]
+		if !ok {
 			err = errors.Wrapf(ErrUnknownProp, "property %s not found in index spec", uintPred.Name)
 			return
 		}
 		if q.OrderBy == uintPred.Name {
-			ifmOrder = ifm
+			p.orderBy = ifm
 		}
-		var bm *pilosa.Bitmap
-		if bm, err = ifm.QueryRangeBetween(uintPred.Low, uintPred.High); err != nil {
+		p.uintPreds = append(p.uintPreds, uintPredPlan{ifm: ifm, low: uintPred.Low, high: uintPred.High})
+	}
+	plan = p
+	return
+}
+
+//selectSlice evaluates the plan on the given slice.
+func (p *selectPlan) selectSlice(slice uint64, limit int) (qr *QueryResult, err error) {
+	qr = NewQueryResult(limit)
+	docs := p.liveDocs.rowSlice(0, slice)
+	for _, strPred := range p.strPreds {
+		for _, termID := range strPred.termIDs {
+			if docs.Count() == 0 {
+				return
+			}
+			docs = docs.Intersect(strPred.tfm.rowSlice(termID, slice))
+		}
+	}
+	for _, existPred := range p.existPreds {
+		if docs.Count() == 0 {
 			return
 		}
-		prevDocs = prevDocs.Intersect(bm)
-		if prevDocs.Count() == 0 {
+		if !existPred.found {
+			continue
+		}
+		bm := p.existProps.rowSlice(existPred.termID, slice)
+		if existPred.exists {
+			docs = docs.Intersect(bm)
+		} else {
+			docs = docs.Difference(bm)
+		}
+	}
+	for _, uintPred := range p.uintPreds {
+		if docs.Count() == 0 {
 			return
 		}
+		var bm *pilosa.Bitmap
+		if bm, err = uintPred.ifm.queryRangeBetweenSlice(uintPred.low, uintPred.high, slice); err != nil {
+			return
+		}
+		docs = docs.Intersect(bm)
 	}
 
-	if ifmOrder == nil {
-		qr.Bm = prevDocs
-	} else {
-		var val uint64
-		var exists bool
-		for _, docID := range prevDocs.Bits() {
-			if val, exists, err = ifmOrder.GetValue(docID); err != nil {
-				return
-			}
-			if exists {
-				point := bkdtree.Point{
-					Vals:     []uint64{val},
-					UserData: docID,
-				}
-				qr.Oa.Put(point)
+	if p.orderBy == nil {
+		qr.Bm = docs
+		return
+	}
+	var val uint64
+	var exists bool
+	for _, docID := range docs.Bits() {
+		if val, exists, err = p.orderBy.GetValue(docID); err != nil {
+			return
+		}
+		if exists {
+			point := bkdtree.Point{
+				Vals:     []uint64{val},
+				UserData: docID,
 			}
+			qr.Oa.Put(point)
 		}
 	}
-
 	return
 }
 
+func hasProp(docProt *cql.DocumentWithIdx, name string) bool {
+	for _, uintProp := range docProt.Doc.UintProps {
+		if uintProp.Name == name {
+			return true
+		}
+	}
+	for _, enumProp := range docProt.Doc.EnumProps {
+		if enumProp.Name == name {
+			return true
+		}
+	}
+	for _, strProp := range docProt.Doc.StrProps {
+		if strProp.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 //GetDocIDFragList returns DocID fragment list. Each fragment's size is pilosa.SliceWidth
 func (ind *Index) GetDocIDFragList() (numList []uint64) {
 	return ind.liveDocs.GetFragList()
 }
+
+//forEachSlice calls fn for each of slices, with at most parallelism goroutines. parallelism <= 0 means runtime.GOMAXPROCS(0).
+//It returns the first error of fn, after which the remaining slices are skipped.
+func forEachSlice(slices []uint64, parallelism int, fn func(slice uint64) error) (err error) {
+	if parallelism <= 0 {
+		parallelism = runtime.GOMAXPROCS(0)
+	}
+	if parallelism > len(slices) {
+		parallelism = len(slices)
+	}
+	if parallelism <= 1 {
+		for _, slice := range slices {
+			if err = fn(slice); err != nil {
+				return
+			}
+		}
+		return
+	}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	next := 0
+	for i := 0; i < parallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				mu.Lock()
+				if err != nil || next >= len(slices) {
+					mu.Unlock()
+					return
+				}
+				slice := slices[next]
+				next++
+				mu.Unlock()
+				if err2 := fn(slice); err2 != nil {
+					mu.Lock()
+					if err == nil {
+						err = err2
+					}
+					mu.Unlock()
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return
+}
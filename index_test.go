@@ -2,10 +2,13 @@ package indexer
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	datastructures "github.com/deepfabric/go-datastructures"
 	"github.com/deepfabric/indexer/cql"
+	"github.com/pilosa/pilosa"
 	"github.com/stretchr/testify/require"
 )
 
@@ -234,3 +237,131 @@ func TestIndexOpenClose(t *testing.T) {
 	err = ind.Destroy()
 	require.NoError(t, err)
 }
+
+//TESTCASE: opening an index created before the existence of properties was recorded backfills it from the live documents
+func TestIndexExistBackfill(t *testing.T) {
+	var err error
+	var ind *Index
+	var qr *QueryResult
+
+	docProt := newDocProt()
+	ind, err = NewIndex(docProt, "/tmp/index_test")
+	require.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		doc := newDocProt()
+		doc.Doc.DocID = uint64(i)
+		err = ind.Insert(doc)
+		require.NoError(t, err)
+	}
+	_, err = ind.Del(0)
+	require.NoError(t, err)
+	err = ind.Close()
+	require.NoError(t, err)
+
+	//simulate an index of the old format
+	err = os.RemoveAll(filepath.Join("/tmp/index_test", docProt.Index, ExistProps))
+	require.NoError(t, err)
+
+	for round := 0; round < 2; round++ {
+		ind, err = NewIndexExt("/tmp/index_test", docProt.Index)
+		require.NoError(t, err)
+		cs := &cql.CqlSelect{
+			Index: docProt.Index,
+			ExistPreds: map[string]cql.ExistPred{
+				"price": cql.ExistPred{
+					Name:   "price",
+					Exists: true,
+				},
+				"note": cql.ExistPred{
+					Name:   "note",
+					Exists: true,
+				},
+			},
+		}
+		qr, err = ind.Select(cs)
+		require.NoError(t, err)
+		require.Equal(t, uint64(99), qr.Bm.Count())
+		cs.ExistPreds = map[string]cql.ExistPred{
+			"date": cql.ExistPred{
+				Name:   "date",
+				Exists: false,
+			},
+		}
+		qr, err = ind.Select(cs)
+		require.NoError(t, err)
+		require.Equal(t, uint64(0), qr.Bm.Count())
+		//the backfilled existence shall be persisted
+		err = ind.Close()
+		require.NoError(t, err)
+	}
+
+	err = ind.Destroy()
+	require.NoError(t, err)
+}
+
+//TESTCASE: a query over multiple slices gets the same result regardless of parallelism
+func TestIndexSelectParallel(t *testing.T) {
+	var err error
+	var ind *Index
+	var qr1, qr2 *QueryResult
+	numSlices := 4
+	docsPerSlice := 100
+
+	docProt := newDocProt()
+	ind, err = NewIndex(docProt, "/tmp/index_test")
+	require.NoError(t, err)
+	for s := 0; s < numSlices; s++ {
+		for i := 0; i < docsPerSlice; i++ {
+			doc := newDocProt()
+			doc.Doc.DocID = uint64(s)*pilosa.SliceWidth + uint64(i)
+			for j := 0; j < len(doc.Doc.UintProps); j++ {
+				doc.Doc.UintProps[j].Val = uint64(s*docsPerSlice + i)
+			}
+			doc.Doc.StrProps[0].Val = fmt.Sprintf("slice%d doc%d", s, i%10)
+			err = ind.Insert(doc)
+			require.NoError(t, err)
+		}
+	}
+	require.Equal(t, numSlices, len(ind.GetDocIDFragList()))
+
+	cs := &cql.CqlSelect{
+		Index: docProt.Index,
+		UintPreds: map[string]cql.UintPred{
+			"price": cql.UintPred{
+				Name: "price",
+				Low:  50,
+				High: 350,
+			},
+		},
+		StrPreds: map[string]cql.StrPred{
+			"description": cql.StrPred{
+				Name:     "description",
+				ContWord: "doc3",
+			},
+		},
+	}
+	ind.Parallelism = 1
+	qr1, err = ind.Select(cs)
+	require.NoError(t, err)
+	ind.Parallelism = numSlices
+	qr2, err = ind.Select(cs)
+	require.NoError(t, err)
+	require.Equal(t, uint64(30), qr1.Bm.Count())
+	require.Equal(t, qr1.Bm.Bits(), qr2.Bm.Bits())
+
+	//TESTCASE: top-N of each slice are merged
+	cs.OrderBy = "price"
+	cs.Limit = 5
+	ind.Parallelism = 1
+	qr1, err = ind.Select(cs)
+	require.NoError(t, err)
+	ind.Parallelism = numSlices
+	qr2, err = ind.Select(cs)
+	require.NoError(t, err)
+	items1, items2 := qr1.Oa.Finalize(), qr2.Oa.Finalize()
+	require.Equal(t, cs.Limit, len(items2))
+	require.Equal(t, items1, items2)
+
+	err = ind.Destroy()
+	require.NoError(t, err)
+}
@@ -2,6 +2,7 @@ package indexer
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"sort"
@@ -20,39 +21,110 @@ import (
 const (
 	// DefaultIndexerMaxOpN is the default value for Indexer.MaxOpN.
 	DefaultIndexerMaxOpN = uint64(1000000)
+	// IndexerMetaFile stores the index of the last applied WAL entry.
+	IndexerMetaFile = "indexer_meta.json"
+)
+
+//Types of the WAL entries written by Indexer
+const (
+	//EntryInsert entry carries a marshaled cql.DocumentWithIdx
+	EntryInsert = walpb.EntryType_EntryInsert
+	//EntryDelete entry carries a marshaled cql.DocumentDel
+	EntryDelete = walpb.EntryType_EntryDelete
+	//EntryAlterIndex entry carries the marshaled new cql.DocumentWithIdx
+	EntryAlterIndex = walpb.EntryType_EntryAlterIndex
+	//EntryCreateIndex entry carries the marshaled cql.DocumentWithIdx
+	EntryCreateIndex = walpb.EntryType_EntryCreateIndex
+	//EntryDestroyIndex entry carries the index name
+	EntryDestroyIndex = walpb.EntryType_EntryDestroyIndex
+	//EntryUpdate entry carries the marshaled new cql.DocumentWithIdx
+	EntryUpdate = walpb.EntryType_EntryUpdate
+	//EntryBatch entry carries a marshaled walpb.Batch of the other types of entries
+	EntryBatch = walpb.EntryType_EntryBatch
 )
 
 var (
-	ErrIdxExist    = errors.New("index already exist")
-	ErrIdxNotExist = errors.New("index not exist")
+	ErrIdxExist         = errors.New("index already exist")
+	ErrIdxNotExist      = errors.New("index not exist")
+	ErrSchemaMismatch   = errors.New("schema mismatch")
+	ErrUnknownEntryType = errors.New("unknown WAL entry type")
 )
 
+//CreateIndexOptions controls the behavior of CreateIndexExt.
+type CreateIndexOptions struct {
+	//Overwrite replaces an existing index which has a different schema, and removes all data of it.
+	//Otherwise such a creation fails with ErrSchemaMismatch.
+	Overwrite bool
+}
+
+//indexerMeta is the content of IndexerMetaFile
+type indexerMeta struct {
+	AppliedIndex uint64
+}
+
+//IndexerOptions controls the behavior of NewIndexerExt.
+type IndexerOptions struct {
+	//Overwrite removes all existing indices and WAL.
+	Overwrite bool
+	//EnableWal writes all operations to WAL, and replays it at start.
+	EnableWal bool
+	//RepairWal truncates a torn write at the tail of WAL if replay hits one, instead of failing.
+	//The entries in the torn write are lost.
+	RepairWal bool
+	//CompressWal compresses the entries of WAL segments created afterwards. Uncompressed segments are still readable.
+	CompressWal bool
+	//QueryParallelism is the max number of slices a query of an index evaluates concurrently. 0 means runtime.GOMAXPROCS(0).
+	QueryParallelism int
+}
+
 //Indexer shall be singleton
 type Indexer struct {
 	MainDir string //the main directory where stores all indices
-	// Number of operations performed before performing a snapshot.
+	// Number of operations performed before performing a checkpoint in background.
 	MaxOpN uint64
 
-	rwlock   sync.RWMutex                    //concurrent access of docProts, indices
-	docProts map[string]*cql.DocumentWithIdx //index meta, need to persist
-	indices  map[string]*Index               //index data, need to persist
-	w        *wal.WAL                        //WAL
-	opN      uint64
-	entIndex uint64
+	rwlock           sync.RWMutex                    //concurrent access of docProts, indices
+	docProts         map[string]*cql.DocumentWithIdx //index meta, need to persist
+	indices          map[string]*Index               //index data, need to persist
+	w                *wal.WAL                        //WAL
+	durability       wal.SyncPolicy                  //sync policy of WAL
+	repairWal        bool                            //whether to repair a torn WAL at replay
+	compressWal      bool                            //whether to compress WAL segments
+	queryParallelism int                             //Index.Parallelism of all indices
+	opN              uint64
+	entIndex         uint64     //index of the last applied WAL entry, need to persist
+	ckptMu           sync.Mutex //serializes checkpoints
+	ckptActive       uint32     //whether a background checkpoint is running
+	applyMu          sync.Mutex //serializes snapshot applies
 }
 
 //NewIndexer creates an Indexer.
 func NewIndexer(mainDir string, overwirte bool, enableWal bool) (ir *Indexer, err error) {
+	ir, err = NewIndexerExt(mainDir, IndexerOptions{Overwrite: overwirte, EnableWal: enableWal})
+	return
+}
+
+//NewIndexerExt creates an Indexer with the given options.
+func NewIndexerExt(mainDir string, opts IndexerOptions) (ir *Indexer, err error) {
 	ir = &Indexer{
-		MainDir:  mainDir,
-		MaxOpN:   DefaultIndexerMaxOpN,
-		entIndex: uint64(0),
+		MainDir:          mainDir,
+		MaxOpN:           DefaultIndexerMaxOpN,
+		entIndex:         uint64(0),
+		repairWal:        opts.RepairWal,
+		compressWal:      opts.CompressWal,
+		queryParallelism: opts.QueryParallelism,
+	}
+	if err = recoverApply(mainDir); err != nil {
+		return
+	}
+	if err = recoverRestore(mainDir); err != nil {
+		return
 	}
 	if err = os.MkdirAll(mainDir, 0700); err != nil {
 		err = errors.Wrap(err, "")
 		return
 	}
-	if overwirte {
+	if opts.Overwrite {
 		ir.docProts = make(map[string]*cql.DocumentWithIdx)
 		ir.indices = make(map[string]*Index)
 		if err = ir.removeIndices(); err != nil {
@@ -63,16 +135,17 @@ func NewIndexer(mainDir string, overwirte bool, enableWal bool) (ir *Indexer, er
 			return
 		}
 	}
-	if enableWal {
+	if opts.EnableWal {
 		walDir := filepath.Join(mainDir, "wal")
-		if overwirte {
+		if opts.Overwrite {
 			if err = os.RemoveAll(walDir); err != nil {
 				err = errors.Wrap(err, "")
 				return
 			}
-			if ir.w, err = wal.Create(walDir); err != nil {
+			if ir.w, err = wal.CreateExt(walDir, wal.Options{Compress: ir.compressWal}); err != nil {
 				return
 			}
+			ir.w.SetSyncPolicy(ir.durability)
 		} else {
 			if err = ir.replayWal(); err != nil {
 				return
@@ -110,6 +183,7 @@ func (ir *Indexer) open() (err error) {
 	}
 	ir.docProts = make(map[string]*cql.DocumentWithIdx)
 	ir.indices = make(map[string]*Index)
+	ir.entIndex = 0
 	if err = ir.readMeta(); err != nil {
 		return
 	}
@@ -118,6 +192,7 @@ func (ir *Indexer) open() (err error) {
 		if ind, err = NewIndexExt(ir.MainDir, docProt.Index); err != nil {
 			return
 		}
+		ind.Parallelism = ir.queryParallelism
 		ir.indices[name] = ind
 	}
 	if ir.w != nil {
@@ -128,6 +203,18 @@ func (ir *Indexer) open() (err error) {
 	return
 }
 
+//discard closes the indices opened by a failed open without persisting anything.
+func (ir *Indexer) discard() {
+	for _, ind := range ir.indices {
+		ind.Close()
+	}
+	if ir.w != nil {
+		ir.w.Close(false)
+	}
+	ir.indices = nil
+	ir.docProts = nil
+}
+
 // Close close indexer
 func (ir *Indexer) Close() (err error) {
 	ir.rwlock.Lock()
@@ -143,6 +230,10 @@ func (ir *Indexer) close() (err error) {
 			return
 		}
 	}
+	//WAL entries are removed at close, so the applied index must be persisted
+	if err = ir.writeAppliedIndex(atomic.LoadUint64(&ir.entIndex)); err != nil {
+		return
+	}
 	if ir.w != nil {
 		if err = ir.w.Close(true); err != nil {
 			return
@@ -168,6 +259,9 @@ func (ir *Indexer) sync() (err error) {
 			return
 		}
 	}
+	if err = ir.writeAppliedIndex(atomic.LoadUint64(&ir.entIndex)); err != nil {
+		return
+	}
 	if ir.w != nil {
 		if err = ir.w.CompactAll(); err != nil {
 			return
@@ -179,6 +273,7 @@ func (ir *Indexer) sync() (err error) {
 func (ir *Indexer) replayWal() (err error) {
 	var w *wal.WAL
 	walDir := filepath.Join(ir.MainDir, "wal")
+	walOpts := wal.Options{Compress: ir.compressWal}
 	_, err = os.Stat(walDir)
 	if err != nil {
 		if !os.IsNotExist(err) {
@@ -186,50 +281,257 @@ func (ir *Indexer) replayWal() (err error) {
 			return
 		}
 		// wal directory doesn't exist
-		if w, err = wal.Create(walDir); err != nil {
+		if w, err = wal.CreateExt(walDir, walOpts); err != nil {
+			return
+		}
+		if err = w.SetLastIndex(ir.entIndex); err != nil {
 			return
 		}
 		ir.w = w
+		ir.w.SetSyncPolicy(ir.durability)
 		return
 	}
 	//replay wal records
-	if w, err = wal.OpenAtBeginning(walDir); err != nil {
+	if w, err = wal.OpenAtBeginningExt(walDir, walOpts); err != nil {
 		return
 	}
 	var ents []walpb.Entry
 	if ents, err = w.ReadAll(); err != nil {
+		if !ir.repairWal || errors.Cause(err) != io.ErrUnexpectedEOF {
+			w.Close(false)
+			return
+		}
+		//a torn write at the tail, which is likely caused by power loss
+		log.Warnf("indexer %v failed to read wal %v, repairing it: %+v", ir.MainDir, walDir, err)
+		if err = w.Close(false); err != nil {
+			return
+		}
+		var records int
+		var bytes int64
+		if records, bytes, err = wal.Repair(walDir); err != nil {
+			return
+		}
+		log.Warnf("indexer %v repaired wal %v, discarded %v records of %v bytes", ir.MainDir, walDir, records, bytes)
+		if w, err = wal.OpenAtBeginningExt(walDir, walOpts); err != nil {
+			return
+		}
+		if ents, err = w.ReadAll(); err != nil {
+			return
+		}
+	}
+	var numApplied int
+	var payload []byte
+	for i := range ents {
+		//entries up to the persisted applied index are already in the indices
+		if ents[i].Index <= ir.entIndex {
+			continue
+		}
+		if payload, err = walpb.UnwrapPayload(ents[i].Data); err != nil {
+			err = errors.Wrapf(err, "failed to unwrap WAL entry %v", ents[i].Index)
+			return
+		}
+		ent := walpb.Entry{Term: ents[i].Term, Index: ents[i].Index, Type: ents[i].Type, Data: payload}
+		if err = ir.applyEntry(&ent); err != nil {
+			err = errors.Wrapf(err, "failed to replay WAL entry %v", ents[i].Index)
+			return
+		}
+		ir.entIndex = ents[i].Index
+		numApplied++
+	}
+	//the WAL could have been removed after the indices were persisted
+	if err = w.SetLastIndex(ir.entIndex); err != nil {
 		return
 	}
-	doc := &cql.DocumentWithIdx{}
-	dd := &cql.DocumentDel{}
-	for _, ent := range ents {
-		switch ent.Type {
-		case 0:
-			if err = doc.Unmarshal(ent.Data); err != nil {
-				err = errors.Wrap(err, "")
-				return
-			}
-			if err = ir.Insert(doc); err != nil {
+	log.Infof("replayed %v of %v entries in %v, applied index %v", numApplied, len(ents), walDir, ir.entIndex)
+	ir.w = w
+	ir.w.SetSyncPolicy(ir.durability)
+	if err = ir.sync(); err != nil {
+		return
+	}
+	return
+}
+
+//applyEntry applies a WAL entry, whose Data isn't enveloped, to the indices without holding the lock. It doesn't write WAL.
+//An insertion replaces the existing document since the document could have been persisted before a crash.
+//The existing document is assumed to be the entry's own one if the index doesn't store documents.
+func (ir *Indexer) applyEntry(ent *walpb.Entry) (err error) {
+	switch ent.Type {
+	case EntryInsert, EntryUpdate:
+		doc := &cql.DocumentWithIdx{}
+		if err = doc.Unmarshal(ent.Data); err != nil {
+			err = errors.Wrap(err, "")
+			return
+		}
+		ind, found := ir.indices[doc.Index]
+		if !found {
+			err = errors.Wrapf(ErrIdxNotExist, "index %v doesn't exist", doc.Index)
+			return
+		}
+		if ent.Type == EntryInsert {
+			if err = ind.Insert(doc); errors.Cause(err) != ErrDocExist {
 				return
 			}
-		default:
-			if err = dd.Unmarshal(ent.Data); err != nil {
-				err = errors.Wrap(err, "")
+		}
+		err = ind.update(doc, &doc.Doc)
+	case EntryDelete:
+		dd := &cql.DocumentDel{}
+		if err = dd.Unmarshal(ent.Data); err != nil {
+			err = errors.Wrap(err, "")
+			return
+		}
+		ind, found := ir.indices[dd.Index]
+		if !found {
+			err = errors.Wrapf(ErrIdxNotExist, "index %v doesn't exist", dd.Index)
+			return
+		}
+		_, err = ind.Del(dd.DocID)
+	case EntryAlterIndex:
+		newDocProt := &cql.DocumentWithIdx{}
+		if err = newDocProt.Unmarshal(ent.Data); err != nil {
+			err = errors.Wrap(err, "")
+			return
+		}
+		err = ir.alterIndex(newDocProt)
+	case EntryCreateIndex:
+		docProt := &cql.DocumentWithIdx{}
+		if err = docProt.Unmarshal(ent.Data); err != nil {
+			err = errors.Wrap(err, "")
+			return
+		}
+		//the creation was validated before it was logged
+		_, err = ir.createIndex(docProt, CreateIndexOptions{Overwrite: true})
+	case EntryDestroyIndex:
+		err = ir.destroyIndex(string(ent.Data))
+	case EntryBatch:
+		batch := &walpb.Batch{}
+		if err = batch.Unmarshal(ent.Data); err != nil {
+			err = errors.Wrap(err, "")
+			return
+		}
+		for i := range batch.Entries {
+			if batch.Entries[i].Type == EntryBatch {
+				err = errors.Wrap(ErrUnknownEntryType, "nested batch")
 				return
 			}
-			if _, err = ir.Del(dd.Index, dd.DocID); err != nil {
+			if err = ir.applyEntry(&batch.Entries[i]); err != nil {
 				return
 			}
 		}
+	default:
+		err = errors.Wrapf(ErrUnknownEntryType, "entry type %v", ent.Type)
 	}
-	log.Infof("replayed %v entries in %v", len(ents), walDir)
-	ir.w = w
-	if err = ir.sync(); err != nil {
+	return
+}
+
+//Apply applies an entry of an externally ordered log, such as a Raft log, and writes it to WAL with the given term and index.
+//ent.Type is one of the Entry* types, and ent.Data is the marshaled payload without envelope.
+//EntryInsert replaces an existing document, and EntryCreateIndex overwrites an existing index which has a different schema.
+//An entry whose index isn't greater than AppliedIndex is ignored and applied is false, so it's safe to re-apply the log.
+//Gaps between indices are allowed. The log shall be the only writer of the Indexer since other writing methods assign their own indices.
+func (ir *Indexer) Apply(term, index uint64, ent *walpb.Entry) (applied bool, err error) {
+	ir.rwlock.Lock()
+	applied, err = ir.apply(term, index, ent)
+	ir.rwlock.Unlock()
+	if err != nil || !applied {
 		return
 	}
+	err = ir._IncrementOpN()
 	return
 }
 
+// apply applies an entry of an externally ordered log without holding the lock
+func (ir *Indexer) apply(term, index uint64, ent *walpb.Entry) (applied bool, err error) {
+	if index <= atomic.LoadUint64(&ir.entIndex) {
+		return
+	}
+	if err = ir.applyEntry(ent); err != nil {
+		return
+	}
+	if ir.w != nil {
+		var data []byte
+		if data, err = walpb.WrapPayload(ent.Data); err != nil {
+			err = errors.Wrap(err, "")
+			return
+		}
+		e := &walpb.Entry{Term: term, Index: index, Type: ent.Type, Data: data}
+		if err = ir.w.SaveEntry(e); err != nil {
+			return
+		}
+	}
+	atomic.StoreUint64(&ir.entIndex, index)
+	applied = true
+	return
+}
+
+//AppliedIndex returns the index of the last WAL entry applied to the indices.
+//External replication layers resume from the entry after it. If WAL is disabled, only Apply advances it,
+//and it's persisted at Sync, Checkpoint and Close.
+func (ir *Indexer) AppliedIndex() uint64 {
+	return atomic.LoadUint64(&ir.entIndex)
+}
+
+//appendEntries envelops and writes ents to WAL with consecutive indices, and advances the applied index.
+func (ir *Indexer) appendEntries(ents []walpb.Entry) (err error) {
+	var lastIndex, cur uint64
+	for i := range ents {
+		if ents[i].Data, err = walpb.WrapPayload(ents[i].Data); err != nil {
+			err = errors.Wrap(err, "")
+			return
+		}
+	}
+	if lastIndex, err = ir.w.Append(ents); err != nil {
+		return
+	}
+	//concurrent writers may finish in a different order than their entries were written
+	for {
+		cur = atomic.LoadUint64(&ir.entIndex)
+		if lastIndex <= cur || atomic.CompareAndSwapUint64(&ir.entIndex, cur, lastIndex) {
+			return
+		}
+	}
+}
+
+//Checkpoint persists the indices, records the applied index, and releases the WAL segments which
+//contain only entries at or before it. Unlike Sync, it doesn't block insertions and deletions.
+func (ir *Indexer) Checkpoint() (err error) {
+	ir.ckptMu.Lock()
+	defer ir.ckptMu.Unlock()
+	ir.rwlock.RLock()
+	defer ir.rwlock.RUnlock()
+	if ir.indices == nil {
+		//indexer is closed
+		return
+	}
+	//every writer applies an entry before writing it to WAL, so all entries up to the applied index are in the indices
+	ckpt := atomic.LoadUint64(&ir.entIndex)
+	for _, ind := range ir.indices {
+		if err = ind.Sync(); err != nil {
+			return
+		}
+	}
+	if err = ir.writeAppliedIndex(ckpt); err != nil {
+		return
+	}
+	if ir.w != nil {
+		if err = ir.w.ReleaseTo(ckpt); err != nil {
+			return
+		}
+	}
+	log.Infof("indexer %v checkpointed at %v", ir.MainDir, ckpt)
+	return
+}
+
+//SetDurability sets the sync policy of WAL, which trades durability of the latest operations for throughput.
+//The default one syncs WAL on every operation.
+func (ir *Indexer) SetDurability(policy wal.SyncPolicy) {
+	ir.rwlock.Lock()
+	ir.durability = policy
+	if ir.w != nil {
+		ir.w.SetSyncPolicy(policy)
+	}
+	ir.rwlock.Unlock()
+}
+
 // GetDocProts dumps docProts
 func (ir *Indexer) GetDocProts() (sdump string) {
 	ir.rwlock.RLock()
@@ -246,21 +548,116 @@ func (ir *Indexer) GetDocProt(name string) (docProt *cql.DocumentWithIdx) {
 	return
 }
 
-// CreateIndex creates index
+// IndexNames returns names of all indices in ascending order
+func (ir *Indexer) IndexNames() (names []string) {
+	ir.rwlock.RLock()
+	names = make([]string, 0, len(ir.docProts))
+	for name := range ir.docProts {
+		names = append(names, name)
+	}
+	ir.rwlock.RUnlock()
+	sort.Strings(names)
+	return
+}
+
+// CreateIndex creates index. It fails with ErrSchemaMismatch if the index exists with a different schema.
 func (ir *Indexer) CreateIndex(docProt *cql.DocumentWithIdx) (err error) {
+	err = ir.CreateIndexExt(docProt, CreateIndexOptions{})
+	return
+}
+
+// CreateIndexExt creates index with the given options
+func (ir *Indexer) CreateIndexExt(docProt *cql.DocumentWithIdx, opts CreateIndexOptions) (err error) {
 	ir.rwlock.Lock()
-	err = ir.createIndex(docProt)
-	ir.rwlock.Unlock()
+	defer ir.rwlock.Unlock()
+	var created bool
+	if created, err = ir.createIndex(docProt, opts); err != nil || !created || ir.w == nil {
+		return
+	}
+	var data []byte
+	if data, err = docProt.Marshal(); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	ents := []walpb.Entry{{Type: EntryCreateIndex, Data: data}}
+	err = ir.appendEntries(ents)
 	return
 }
 
-//DestroyIndex destroy given index
+//AlterIndex adds and drops properties of the given index. Data of the other properties is kept intact.
+func (ir *Indexer) AlterIndex(q *cql.CqlAlter) (err error) {
+	ir.rwlock.Lock()
+	defer ir.rwlock.Unlock()
+	curDocProt, found := ir.docProts[q.Index]
+	if !found {
+		err = errors.Wrapf(ErrIdxNotExist, "index %v doesn't exist", q.Index)
+		return
+	}
+	var newDocProt *cql.DocumentWithIdx
+	if newDocProt, err = alterDocProt(curDocProt, q); err != nil {
+		return
+	}
+	//persist pending operations so that WAL replay never applies a document of the old schema to the new one
+	if err = ir.sync(); err != nil {
+		return
+	}
+	if ir.w != nil {
+		var data []byte
+		if data, err = newDocProt.Marshal(); err != nil {
+			err = errors.Wrap(err, "")
+			return
+		}
+		ents := []walpb.Entry{{Type: EntryAlterIndex, Data: data}}
+		if err = ir.appendEntries(ents); err != nil {
+			return
+		}
+	}
+	err = ir.alterIndex(newDocProt)
+	return
+}
+
+// alterIndex changes the schema of an existing index without holding the lock
+func (ir *Indexer) alterIndex(newDocProt *cql.DocumentWithIdx) (err error) {
+	ind, found := ir.indices[newDocProt.Index]
+	if !found {
+		err = errors.Wrapf(ErrIdxNotExist, "index %v doesn't exist", newDocProt.Index)
+		return
+	}
+	if err = ind.Alter(newDocProt); err != nil {
+		return
+	}
+	ir.docProts[newDocProt.Index] = newDocProt
+	log.Infof("indexer %v altered index %v, new schema %+v", ir.MainDir, newDocProt.Index, newDocProt)
+	return
+}
+
+//DestroyIndex destroy given index. It's allowed that the given index doesn't exist.
 func (ir *Indexer) DestroyIndex(name string) (err error) {
 	ir.rwlock.Lock()
+	defer ir.rwlock.Unlock()
+	if _, found := ir.indices[name]; !found {
+		return
+	}
+	if err = ir.destroyIndex(name); err != nil || ir.w == nil {
+		return
+	}
+	ents := []walpb.Entry{{Type: EntryDestroyIndex, Data: []byte(name)}}
+	err = ir.appendEntries(ents)
+	return
+}
+
+// destroyIndex closes and removes given index without holding the lock. It's allowed that the given index doesn't exist.
+func (ir *Indexer) destroyIndex(name string) (err error) {
+	ind, found := ir.indices[name]
+	if !found {
+		return
+	}
+	if err = ind.Close(); err != nil {
+		return
+	}
 	delete(ir.indices, name)
 	delete(ir.docProts, name)
 	err = ir.removeIndex(name)
-	ir.rwlock.Unlock()
 	return
 }
 
@@ -285,9 +682,8 @@ func (ir *Indexer) Insert(doc *cql.DocumentWithIdx) (err error) {
 			err = errors.Wrap(err, "")
 			return
 		}
-		entIndex := atomic.AddUint64(&ir.entIndex, uint64(1))
-		e := &walpb.Entry{Index: entIndex, Data: data}
-		if err = ir.w.SaveEntry(e); err != nil {
+		ents := []walpb.Entry{{Type: EntryInsert, Data: data}}
+		if err = ir.appendEntries(ents); err != nil {
 			ir.rwlock.RUnlock()
 			return
 		}
@@ -324,9 +720,42 @@ func (ir *Indexer) Del(idxName string, docID uint64) (found bool, err error) {
 			err = errors.Wrap(err, "")
 			return
 		}
-		entIndex := atomic.AddUint64(&ir.entIndex, uint64(1))
-		e := &walpb.Entry{Index: entIndex, Type: walpb.EntryType(1), Data: data}
-		if err = ir.w.SaveEntry(e); err != nil {
+		ents := []walpb.Entry{{Type: EntryDelete, Data: data}}
+		if err = ir.appendEntries(ents); err != nil {
+			ir.rwlock.RUnlock()
+			return
+		}
+	}
+	ir.rwlock.RUnlock()
+	if err = ir._IncrementOpN(); err != nil {
+		return
+	}
+	return
+}
+
+//Update replaces the document of the same DocID, or inserts it if there's none.
+func (ir *Indexer) Update(doc *cql.DocumentWithIdx) (err error) {
+	var ind *Index
+	var found bool
+	ir.rwlock.RLock()
+	if ind, found = ir.indices[doc.Index]; !found {
+		ir.rwlock.RUnlock()
+		err = errors.Wrapf(ErrIdxNotExist, "index %v doesn't exist", doc.Index)
+		return
+	}
+	if err = ind.Update(doc); err != nil {
+		ir.rwlock.RUnlock()
+		return
+	}
+	if ir.w != nil {
+		var data []byte
+		if data, err = doc.Marshal(); err != nil {
+			ir.rwlock.RUnlock()
+			err = errors.Wrap(err, "")
+			return
+		}
+		ents := []walpb.Entry{{Type: EntryUpdate, Data: data}}
+		if err = ir.appendEntries(ents); err != nil {
 			ir.rwlock.RUnlock()
 			return
 		}
@@ -338,15 +767,141 @@ func (ir *Indexer) Del(idxName string, docID uint64) (found bool, err error) {
 	return
 }
 
+//InsertBatch executes a batch of CqlInsert. All succeeded insertions are written to WAL as a single batch entry.
+//errs[i] is the error of docs[i], or nil if it succeeded. err is the error of writing WAL.
+func (ir *Indexer) InsertBatch(docs []*cql.DocumentWithIdx) (errs []error, err error) {
+	var ind *Index
+	var found bool
+	var data []byte
+	var numOps uint64
+	errs = make([]error, len(docs))
+	ents := make([]walpb.Entry, 0)
+	ir.rwlock.RLock()
+	for i, doc := range docs {
+		if ind, found = ir.indices[doc.Index]; !found {
+			errs[i] = errors.Wrapf(ErrIdxNotExist, "index %v doesn't exist", doc.Index)
+			continue
+		}
+		if ir.w != nil {
+			if data, errs[i] = doc.Marshal(); errs[i] != nil {
+				errs[i] = errors.Wrap(errs[i], "")
+				continue
+			}
+		}
+		if errs[i] = ind.Insert(doc); errs[i] != nil {
+			continue
+		}
+		numOps++
+		if ir.w != nil {
+			ents = append(ents, walpb.Entry{Type: EntryInsert, Data: data})
+		}
+	}
+	if len(ents) != 0 {
+		if err = ir.appendBatch(ents); err != nil {
+			ir.rwlock.RUnlock()
+			return
+		}
+	}
+	ir.rwlock.RUnlock()
+	err = ir._IncreaseOpN(numOps)
+	return
+}
+
+//DelBatch executes a batch of CqlDel. All succeeded deletions are written to WAL as a single batch entry.
+//founds[i] and errs[i] are the result of dels[i]. err is the error of writing WAL.
+func (ir *Indexer) DelBatch(dels []*cql.DocumentDel) (founds []bool, errs []error, err error) {
+	var ind *Index
+	var fnd bool
+	var data []byte
+	var numOps uint64
+	founds = make([]bool, len(dels))
+	errs = make([]error, len(dels))
+	ents := make([]walpb.Entry, 0)
+	ir.rwlock.RLock()
+	for i, dd := range dels {
+		if ind, fnd = ir.indices[dd.Index]; !fnd {
+			errs[i] = errors.Wrapf(ErrIdxNotExist, "index %v doesn't exist", dd.Index)
+			continue
+		}
+		if ir.w != nil {
+			if data, errs[i] = dd.Marshal(); errs[i] != nil {
+				errs[i] = errors.Wrap(errs[i], "")
+				continue
+			}
+		}
+		if founds[i], errs[i] = ind.Del(dd.DocID); errs[i] != nil {
+			continue
+		}
+		numOps++
+		if ir.w != nil {
+			ents = append(ents, walpb.Entry{Type: EntryDelete, Data: data})
+		}
+	}
+	if len(ents) != 0 {
+		if err = ir.appendBatch(ents); err != nil {
+			ir.rwlock.RUnlock()
+			return
+		}
+	}
+	ir.rwlock.RUnlock()
+	err = ir._IncreaseOpN(numOps)
+	return
+}
+
+//appendBatch writes ents to WAL as a single EntryBatch entry.
+func (ir *Indexer) appendBatch(ents []walpb.Entry) (err error) {
+	batch := &walpb.Batch{Entries: ents}
+	var data []byte
+	if data, err = batch.Marshal(); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	err = ir.appendEntries([]walpb.Entry{{Type: EntryBatch, Data: data}})
+	return
+}
+
+//Get returns the original document of the given docID. The index shall be created with StoreDoc set.
+func (ir *Indexer) Get(idxName string, docID uint64) (doc *cql.Document, found bool, err error) {
+	var ind *Index
+	var fnd bool
+	ir.rwlock.RLock()
+	if ind, fnd = ir.indices[idxName]; !fnd {
+		ir.rwlock.RUnlock()
+		err = errors.Wrapf(ErrIdxNotExist, "index %v doesn't exist", idxName)
+		return
+	}
+	ir.rwlock.RUnlock()
+	doc, found, err = ind.Get(docID)
+	return
+}
+
 // _IncrementOpN increase the operation count by one.
-// If the count exceeds the maximum allowed then a snapshot is performed.
+// If the count exceeds the maximum allowed then a checkpoint is performed in background.
 func (ir *Indexer) _IncrementOpN() (err error) {
-	opN := atomic.AddUint64(&ir.opN, uint64(1))
+	err = ir._IncreaseOpN(1)
+	return
+}
+
+// _IncreaseOpN increase the operation count by delta.
+// If the count exceeds the maximum allowed then a checkpoint is performed in background.
+func (ir *Indexer) _IncreaseOpN(delta uint64) (err error) {
+	if delta == 0 {
+		return
+	}
+	opN := atomic.AddUint64(&ir.opN, delta)
 	if opN <= ir.MaxOpN {
 		return
 	}
 	atomic.StoreUint64(&ir.opN, 0)
-	err = ir.Sync()
+	if !atomic.CompareAndSwapUint32(&ir.ckptActive, 0, 1) {
+		return
+	}
+	go func() {
+		if err := ir.Checkpoint(); err != nil {
+			log.Errorf("indexer %v checkpoint failed: %+v", ir.MainDir, err)
+		}
+		atomic.StoreUint32(&ir.ckptActive, 0)
+	}()
 	return
 }
 
@@ -361,7 +916,12 @@ func (ir *Indexer) Select(q *cql.CqlSelect) (qr *QueryResult, err error) {
 		return
 	}
 	ir.rwlock.RUnlock()
-	qr, err = ind.Select(q)
+	if qr, err = ind.Select(q); err != nil {
+		return
+	}
+	if q.Highlight {
+		err = ind.Highlight(q, qr)
+	}
 	return
 }
 
@@ -381,17 +941,29 @@ func (ir *Indexer) Summary() (sum string, err error) {
 	return
 }
 
-// createIndex creates index without holding the lock
-func (ir *Indexer) createIndex(docProt *cql.DocumentWithIdx) (err error) {
+// createIndex creates index without holding the lock. created is false if the index already exists with the same schema.
+func (ir *Indexer) createIndex(docProt *cql.DocumentWithIdx, opts CreateIndexOptions) (created bool, err error) {
+	//the caller's struct is kept unchanged
+	cp := *docProt
+	docProt = &cp
+	docProt.Version = 1
 	if curDocProt, found := ir.docProts[docProt.Index]; found {
 		if isSameSchema(curDocProt, docProt) {
 			return
 		}
-		//TODO: on line schema change
-		log.Infof("indexer %v createIndex with the different schema, current one %+v, new one %+v", ir.MainDir, curDocProt, docProt)
+		//use AlterIndex for online schema change
+		if !opts.Overwrite {
+			err = errors.Wrapf(ErrSchemaMismatch, "index %v exists with a different schema, current one %+v, new one %+v", docProt.Index, curDocProt, docProt)
+			return
+		}
+		log.Infof("indexer %v createIndex overwrites the different schema, current one %+v, new one %+v", ir.MainDir, curDocProt, docProt)
+		if err = ir.indices[docProt.Index].Close(); err != nil {
+			return
+		}
 		if err = ir.removeIndex(docProt.Index); err != nil {
 			return
 		}
+		docProt.Version = curDocProt.Version + 1
 	}
 	if err = indexWriteConf(ir.MainDir, docProt); err != nil {
 		return
@@ -400,8 +972,10 @@ func (ir *Indexer) createIndex(docProt *cql.DocumentWithIdx) (err error) {
 	if ind, err = NewIndex(docProt, ir.MainDir); err != nil {
 		return
 	}
+	ind.Parallelism = ir.queryParallelism
 	ir.indices[docProt.Index] = ind
 	ir.docProts[docProt.Index] = docProt
+	created = true
 	return
 }
 
@@ -432,6 +1006,28 @@ func (ir *Indexer) readMeta() (err error) {
 		}
 		ir.docProts[match[1]] = &doc
 	}
+	fp := filepath.Join(ir.MainDir, IndexerMetaFile)
+	if _, err = os.Stat(fp); err != nil {
+		if os.IsNotExist(err) {
+			err = nil
+		} else {
+			err = errors.Wrap(err, "")
+		}
+		return
+	}
+	var meta indexerMeta
+	if err = bkdtree.FileUnmarshal(fp, &meta); err != nil {
+		return
+	}
+	ir.entIndex = meta.AppliedIndex
+	return
+}
+
+//writeAppliedIndex persists the given applied index to IndexerMetaFile.
+func (ir *Indexer) writeAppliedIndex(appliedIndex uint64) (err error) {
+	fp := filepath.Join(ir.MainDir, IndexerMetaFile)
+	meta := indexerMeta{AppliedIndex: appliedIndex}
+	err = bkdtree.FileMarshal(fp, &meta)
 	return
 }
 
@@ -446,6 +1042,14 @@ func (ir *Indexer) removeIndices() (err error) {
 			return
 		}
 	}
+	if err = os.Remove(filepath.Join(ir.MainDir, IndexerMetaFile)); err != nil {
+		if os.IsNotExist(err) {
+			err = nil
+		} else {
+			err = errors.Wrap(err, "")
+		}
+	}
+	ir.entIndex = 0
 	return
 }
 
@@ -486,56 +1090,184 @@ func (ir *Indexer) getDocIDFragList() (numList []uint64) {
 	return
 }
 
+//CreateSnapshot creates a full snapshot of all indices in snapDir. See CreateSnapshotExt for details.
+//The snapshot records the applied index, which AppliedIndex returns after ApplySnapshot.
 func (ir *Indexer) CreateSnapshot(snapDir string) (numList []uint64, err error) {
+	_, numList, err = ir.CreateSnapshotExt(snapDir, SnapshotOptions{})
+	return
+}
+
+//ApplySnapshot replaces all indices with the ones of snapDir.
+//The snapshot is copied to a staging directory beside MainDir, verified against its manifest and opened there.
+//Then MainDir is swapped with it by renaming. The previous MainDir is kept until the new one is open, so a failed
+//apply leaves the previous state intact. NewIndexer finishes or rolls back an apply interrupted by a crash.
+//An incremental snapshot shall be completed by CompleteSnapshot before, otherwise it fails with ErrIncompleteSnapshot.
+func (ir *Indexer) ApplySnapshot(snapDir string) (err error) {
+	var manifest *SnapshotManifest
+	//snapshots created by the older versions have no manifest
+	if _, err = os.Stat(filepath.Join(snapDir, SnapshotManifestFile)); err == nil {
+		if manifest, err = ReadSnapshotManifest(snapDir); err != nil {
+			return
+		}
+		if len(manifest.Omitted) != 0 {
+			err = errors.Wrapf(ErrIncompleteSnapshot, "%v fragments of snapshot %v are omitted", len(manifest.Omitted), snapDir)
+			return
+		}
+	} else if !os.IsNotExist(err) {
+		err = errors.Wrap(err, "")
+		return
+	}
+	ir.applyMu.Lock()
+	defer ir.applyMu.Unlock()
+	mainDir := filepath.Clean(ir.MainDir)
+	stage := mainDir + stagedDirSuffix
+	if err = stageSnapshot(filepath.Join(snapDir, "index"), stage, manifest); err != nil {
+		os.RemoveAll(stage)
+		return
+	}
+
 	ir.rwlock.Lock()
 	defer ir.rwlock.Unlock()
-	if err = ir.sync(); err != nil {
+	if err = ir.close(); err != nil {
 		return
 	}
-	src := ir.MainDir
-	dst := filepath.Join(snapDir, "index")
-	if err = os.RemoveAll(dst); err != nil {
+	previous := mainDir + previousDirSuffix
+	if err = swapDir(mainDir, stage, previous); err != nil {
+		os.RemoveAll(stage)
+		if err2 := ir.open(); err2 != nil {
+			log.Errorf("indexer %v failed to reopen: %+v", mainDir, err2)
+		}
 		return
 	}
-	if err = CopyDir(src, dst); err != nil {
+	if err = ir.open(); err != nil {
+		ir.discard()
+		log.Errorf("indexer %v failed to open snapshot %v, rolling back: %+v", mainDir, snapDir, err)
+		if err2 := swapDir(mainDir, previous, stage); err2 != nil {
+			log.Errorf("indexer %v failed to roll back, the previous data is kept at %v: %+v", mainDir, previous, err2)
+			return
+		}
+		os.RemoveAll(stage)
+		if err2 := ir.open(); err2 != nil {
+			log.Errorf("indexer %v failed to reopen: %+v", mainDir, err2)
+		}
 		return
 	}
-	numList = ir.getDocIDFragList()
+	if err = os.RemoveAll(previous); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	log.Infof("applied snapshot %v, applied index %v, docProts %+v", snapDir, ir.entIndex, ir.docProts)
 	return
 }
 
-func (ir *Indexer) ApplySnapshot(snapDir string) (err error) {
-	ir.rwlock.Lock()
-	defer ir.rwlock.Unlock()
-	if err = ir.close(); err != nil {
+//alterDocProt returns a copy of docProt with properties added and dropped as q specifies.
+func alterDocProt(docProt *cql.DocumentWithIdx, q *cql.CqlAlter) (newDocProt *cql.DocumentWithIdx, err error) {
+	var data []byte
+	if data, err = docProt.Marshal(); err != nil {
+		err = errors.Wrap(err, "")
 		return
 	}
-	if err = os.RemoveAll(ir.MainDir); err != nil {
+	newDocProt = &cql.DocumentWithIdx{}
+	if err = newDocProt.Unmarshal(data); err != nil {
+		err = errors.Wrap(err, "")
 		return
 	}
-	src := filepath.Join(snapDir, "index")
-	dst := ir.MainDir
-	_, err = os.Stat(src)
-	if os.IsNotExist(err) {
-		log.Infof("snapshot source directory %v doesn't exist, treating it as an empty one", src)
-		if err = os.MkdirAll(dst, 0700); err != nil {
-			err = errors.Wrap(err, "")
+	newDocProt.Version = docProt.Version + 1
+	names := make(map[string]bool)
+	for _, uintProp := range docProt.Doc.UintProps {
+		names[uintProp.Name] = true
+	}
+	for _, enumProp := range docProt.Doc.EnumProps {
+		names[enumProp.Name] = true
+	}
+	for _, strProp := range docProt.Doc.StrProps {
+		names[strProp.Name] = true
+	}
+	drop := make(map[string]bool)
+	for _, name := range q.DropProps {
+		if !names[name] {
+			err = errors.Wrapf(ErrUnknownProp, "property %v is missing at index spec %v", name, docProt)
 			return
 		}
-	} else {
-		if err = CopyDir(src, dst); err != nil {
+		drop[name] = true
+	}
+
+	uintProps := make([]*cql.UintProp, 0)
+	for _, uintProp := range newDocProt.Doc.UintProps {
+		if !drop[uintProp.Name] {
+			uintProps = append(uintProps, uintProp)
+		}
+	}
+	enumProps := make([]*cql.EnumProp, 0)
+	for _, enumProp := range newDocProt.Doc.EnumProps {
+		if !drop[enumProp.Name] {
+			enumProps = append(enumProps, enumProp)
+		}
+	}
+	strProps := make([]*cql.StrProp, 0)
+	for _, strProp := range newDocProt.Doc.StrProps {
+		if !drop[strProp.Name] {
+			strProps = append(strProps, strProp)
+		}
+	}
+
+	//a property can't be re-added at once since its frame would be reused
+	for _, uintProp := range q.AddProps.UintProps {
+		if names[uintProp.Name] {
+			err = errors.Wrapf(ErrPropExist, "property %v is already at index spec %v", uintProp.Name, docProt)
 			return
 		}
+		names[uintProp.Name] = true
+		uintProps = append(uintProps, &cql.UintProp{Name: uintProp.Name, IsFloat: uintProp.IsFloat, ValLen: uintProp.ValLen})
 	}
-	if err = ir.open(); err != nil {
-		return
+	for _, enumProp := range q.AddProps.EnumProps {
+		if names[enumProp.Name] {
+			err = errors.Wrapf(ErrPropExist, "property %v is already at index spec %v", enumProp.Name, docProt)
+			return
+		}
+		names[enumProp.Name] = true
+		enumProps = append(enumProps, &cql.EnumProp{Name: enumProp.Name})
 	}
-	log.Infof("applied snapshot %v, docProts %+v", src, ir.docProts)
+	for _, strProp := range q.AddProps.StrProps {
+		if names[strProp.Name] {
+			err = errors.Wrapf(ErrPropExist, "property %v is already at index spec %v", strProp.Name, docProt)
+			return
+		}
+		names[strProp.Name] = true
+		strProps = append(strProps, &cql.StrProp{Name: strProp.Name})
+	}
+	newDocProt.Doc.UintProps = uintProps
+	newDocProt.Doc.EnumProps = enumProps
+	newDocProt.Doc.StrProps = strProps
+
+	defaults := &newDocProt.Defaults
+	uintProps = make([]*cql.UintProp, 0)
+	for _, uintProp := range defaults.UintProps {
+		if !drop[uintProp.Name] {
+			uintProps = append(uintProps, uintProp)
+		}
+	}
+	enumProps = make([]*cql.EnumProp, 0)
+	for _, enumProp := range defaults.EnumProps {
+		if !drop[enumProp.Name] {
+			enumProps = append(enumProps, enumProp)
+		}
+	}
+	strProps = make([]*cql.StrProp, 0)
+	for _, strProp := range defaults.StrProps {
+		if !drop[strProp.Name] {
+			strProps = append(strProps, strProp)
+		}
+	}
+	defaults.UintProps = append(uintProps, q.Defaults.UintProps...)
+	defaults.EnumProps = append(enumProps, q.Defaults.EnumProps...)
+	defaults.StrProps = append(strProps, q.Defaults.StrProps...)
 	return
 }
 
 func isSameSchema(docProt1, docProt2 *cql.DocumentWithIdx) bool {
 	if docProt1.Index != docProt2.Index ||
+		docProt1.StoreDoc != docProt2.StoreDoc ||
 		len(docProt1.Doc.UintProps) != len(docProt2.Doc.UintProps) ||
 		len(docProt1.Doc.EnumProps) != len(docProt2.Doc.EnumProps) ||
 		len(docProt1.Doc.StrProps) != len(docProt2.Doc.StrProps) {
@@ -564,5 +1296,40 @@ func isSameSchema(docProt1, docProt2 *cql.DocumentWithIdx) bool {
 			return false
 		}
 	}
+	return isSameDefaults(&docProt1.Defaults, &docProt2.Defaults)
+}
+
+func isSameDefaults(defaults1, defaults2 *cql.Document) bool {
+	if len(defaults1.UintProps) != len(defaults2.UintProps) ||
+		len(defaults1.EnumProps) != len(defaults2.EnumProps) ||
+		len(defaults1.StrProps) != len(defaults2.StrProps) {
+		return false
+	}
+	for i := 0; i < len(defaults1.UintProps); i++ {
+		uintProp1 := defaults1.UintProps[i]
+		uintProp2 := defaults2.UintProps[i]
+		if uintProp1.Name != uintProp2.Name ||
+			uintProp1.IsFloat != uintProp2.IsFloat ||
+			uintProp1.ValLen != uintProp2.ValLen ||
+			uintProp1.Val != uintProp2.Val {
+			return false
+		}
+	}
+	for i := 0; i < len(defaults1.EnumProps); i++ {
+		enumProp1 := defaults1.EnumProps[i]
+		enumProp2 := defaults2.EnumProps[i]
+		if enumProp1.Name != enumProp2.Name ||
+			enumProp1.Val != enumProp2.Val {
+			return false
+		}
+	}
+	for i := 0; i < len(defaults1.StrProps); i++ {
+		strProp1 := defaults1.StrProps[i]
+		strProp2 := defaults2.StrProps[i]
+		if strProp1.Name != strProp2.Name ||
+			strProp1.Val != strProp2.Val {
+			return false
+		}
+	}
 	return true
 }
@@ -1,8 +1,15 @@
 package indexer
 
 import (
+	"archive/tar"
+	"bytes"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -12,6 +19,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/deepfabric/indexer/cql"
+	"github.com/deepfabric/indexer/wal/walpb"
 )
 
 func newDocProt1() *cql.DocumentWithIdx {
@@ -197,10 +205,21 @@ func TestIndexerCorner(t *testing.T) {
 	err = ir.Insert(docProt2)
 	require.Equal(t, errors.Cause(err), ErrIdxNotExist)
 
-	//create index 1 again with different schema. shall be ok.
+	//create index 1 again with the same schema. shall be ok.
+	err = ir.CreateIndex(newDocProt1())
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), ir.GetDocProt(docProt1.Index).Version)
+
+	//create index 1 again with different schema. shall fail.
 	docProt2.Index = docProt1.Index
 	err = ir.CreateIndex(docProt2)
+	require.Equal(t, ErrSchemaMismatch, errors.Cause(err))
+
+	//create index 1 again with different schema and overwrite. shall be ok.
+	err = ir.CreateIndexExt(docProt2, CreateIndexOptions{Overwrite: true})
 	require.NoError(t, err)
+	require.Equal(t, uint32(2), ir.GetDocProt(docProt1.Index).Version)
+	require.Equal(t, uint32(0), docProt2.Version)
 
 	//insert documents
 	for i := 0; i < initialNumDocs; i++ {
@@ -443,6 +462,1224 @@ func TestIndexerSnap(t *testing.T) {
 	require.NotEqual(t, 0, qr.Bm.Count())
 }
 
+func TestIndexerGet(t *testing.T) {
+	var err error
+	var ir *Indexer
+	var doc *cql.Document
+	var found bool
+	initialNumDocs := 137
+
+	//create empty indexer
+	ir, err = NewIndexer("/tmp/indexer_test", true, false)
+	require.NoError(t, err)
+
+	//create index 1 which stores documents
+	docProt := newDocProt1()
+	docProt.StoreDoc = true
+	err = ir.CreateIndex(docProt)
+	require.NoError(t, err)
+
+	//create index 2 which doesn't store documents
+	err = ir.CreateIndex(newDocProt2())
+	require.NoError(t, err)
+
+	//insert documents
+	for i := 0; i < initialNumDocs; i++ {
+		doc := newDocProt1()
+		doc.Doc.DocID = uint64(i)
+		for j := 0; j < len(doc.Doc.StrProps); j++ {
+			doc.Doc.StrProps[j].Val = fmt.Sprintf("%03d%03d and some random text", i, j)
+		}
+		err = ir.Insert(doc)
+		require.NoError(t, err)
+	}
+
+	//get a document
+	doc, found, err = ir.Get("orders", 3)
+	require.NoError(t, err)
+	require.Equal(t, true, found)
+	require.Equal(t, "003000 and some random text", doc.StrProps[0].Val)
+
+	//get a deleted document
+	found, err = ir.Del("orders", 3)
+	require.NoError(t, err)
+	require.Equal(t, true, found)
+	_, found, err = ir.Get("orders", 3)
+	require.NoError(t, err)
+	require.Equal(t, false, found)
+
+	//get a document of an index without document store shall fail
+	_, _, err = ir.Get("addrs", 3)
+	require.Equal(t, ErrNoDocStore, errors.Cause(err))
+
+	//documents survive close and open
+	err = ir.Close()
+	require.NoError(t, err)
+	err = ir.Open()
+	require.NoError(t, err)
+	doc, found, err = ir.Get("orders", 5)
+	require.NoError(t, err)
+	require.Equal(t, true, found)
+	require.Equal(t, "005001 and some random text", doc.StrProps[1].Val)
+	err = ir.Close()
+	require.NoError(t, err)
+}
+
+func TestIndexerAlter(t *testing.T) {
+	var err error
+	var ir, ir2 *Indexer
+	var qr *QueryResult
+	initialNumDocs := 137
+
+	//create empty indexer
+	ir, err = NewIndexer("/tmp/indexer_test", true, true)
+	require.NoError(t, err)
+	err = ir.CreateIndex(newDocProt1())
+	require.NoError(t, err)
+
+	//insert documents with the original schema
+	for i := 0; i < initialNumDocs; i++ {
+		doc := newDocProt1()
+		doc.Doc.DocID = uint64(i)
+		for j := 0; j < len(doc.Doc.UintProps); j++ {
+			doc.Doc.UintProps[j].Val = uint64(i * (j + 1))
+		}
+		err = ir.Insert(doc)
+		require.NoError(t, err)
+	}
+
+	//TESTCASE: add and drop properties
+	q := &cql.CqlAlter{
+		Index: "orders",
+		AddProps: cql.Document{
+			UintProps: []*cql.UintProp{
+				&cql.UintProp{
+					Name:   "weight",
+					ValLen: 4,
+				},
+			},
+		},
+		DropProps: []string{"number", "note"},
+	}
+	err = ir.AlterIndex(q)
+	require.NoError(t, err)
+	docProt := ir.GetDocProt("orders")
+	require.Equal(t, uint32(2), docProt.Version)
+	require.Equal(t, 4, len(docProt.Doc.UintProps))
+	require.Equal(t, "weight", docProt.Doc.UintProps[3].Name)
+	require.Equal(t, 1, len(docProt.Doc.StrProps))
+	_, err = os.Stat("/tmp/indexer_test/orders/number")
+	require.Equal(t, true, os.IsNotExist(err))
+
+	//TESTCASE: add an existing property or drop an unknown property shall fail
+	err = ir.AlterIndex(q)
+	require.Equal(t, ErrPropExist, errors.Cause(err))
+	err = ir.AlterIndex(&cql.CqlAlter{Index: "orders", DropProps: []string{"unknown"}})
+	require.Equal(t, ErrUnknownProp, errors.Cause(err))
+	err = ir.AlterIndex(&cql.CqlAlter{Index: "unknown"})
+	require.Equal(t, ErrIdxNotExist, errors.Cause(err))
+
+	//insert documents with the new schema
+	for i := initialNumDocs; i < 2*initialNumDocs; i++ {
+		doc := &cql.DocumentWithIdx{}
+		*doc = *docProt
+		doc.Doc.DocID = uint64(i)
+		doc.Doc.UintProps = nil
+		for j, uintProp := range docProt.Doc.UintProps {
+			doc.Doc.UintProps = append(doc.Doc.UintProps, &cql.UintProp{Name: uintProp.Name, ValLen: uintProp.ValLen, Val: uint64(i * (j + 1))})
+		}
+		err = ir.Insert(doc)
+		require.NoError(t, err)
+	}
+
+	//TESTCASE: schema change survives WAL replay, and data of existing properties is kept
+	err = ir.Close()
+	require.NoError(t, err)
+	ir2, err = NewIndexer("/tmp/indexer_test", false, true)
+	require.NoError(t, err)
+	require.Equal(t, true, isSameSchema(docProt, ir2.GetDocProt("orders")))
+	cs := &cql.CqlSelect{
+		Index: "orders",
+		UintPreds: map[string]cql.UintPred{
+			"price": cql.UintPred{
+				Name: "price",
+				Low:  0,
+				High: ^uint64(0),
+			},
+		},
+	}
+	qr, err = ir2.Select(cs)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2*initialNumDocs), qr.Bm.Count())
+	cs.UintPreds = map[string]cql.UintPred{
+		"weight": cql.UintPred{
+			Name: "weight",
+			Low:  0,
+			High: ^uint64(0),
+		},
+	}
+	qr, err = ir2.Select(cs)
+	require.NoError(t, err)
+	require.Equal(t, uint64(initialNumDocs), qr.Bm.Count())
+	err = ir2.Close()
+	require.NoError(t, err)
+}
+
+func TestIndexerDefaults(t *testing.T) {
+	var err error
+	var ir *Indexer
+	var qr *QueryResult
+	var doc *cql.Document
+	var found bool
+	initialNumDocs := 137
+
+	//create index with default values of price and note
+	ir, err = NewIndexer("/tmp/indexer_test", true, false)
+	require.NoError(t, err)
+	docProt := newDocProt1()
+	docProt.StoreDoc = true
+	docProt.Defaults.UintProps = []*cql.UintProp{
+		&cql.UintProp{
+			Name:   "price",
+			ValLen: 4,
+			Val:    7,
+		},
+	}
+	docProt.Defaults.StrProps = []*cql.StrProp{
+		&cql.StrProp{
+			Name: "note",
+			Val:  "none",
+		},
+	}
+	err = ir.CreateIndex(docProt)
+	require.NoError(t, err)
+
+	//TESTCASE: create the index again with different default values. shall fail.
+	docProt2 := newDocProt1()
+	docProt2.StoreDoc = true
+	docProt2.Defaults.UintProps = []*cql.UintProp{
+		&cql.UintProp{
+			Name:   "price",
+			ValLen: 4,
+			Val:    8,
+		},
+	}
+	docProt2.Defaults.StrProps = docProt.Defaults.StrProps
+	err = ir.CreateIndex(docProt2)
+	require.Equal(t, ErrSchemaMismatch, errors.Cause(err))
+	docProt2.Defaults.UintProps[0].Val = 7
+	err = ir.CreateIndex(docProt2)
+	require.NoError(t, err)
+
+	//insert documents with some properties absent. date is set for even documents only.
+	for i := 0; i < initialNumDocs; i++ {
+		doc := &cql.DocumentWithIdx{
+			Doc: cql.Document{
+				DocID: uint64(i),
+				UintProps: []*cql.UintProp{
+					&cql.UintProp{
+						Name:   "object",
+						ValLen: 8,
+						Val:    uint64(i),
+					},
+				},
+				StrProps: []*cql.StrProp{
+					&cql.StrProp{
+						Name: "description",
+						Val:  fmt.Sprintf("order %03d", i),
+					},
+				},
+			},
+			Index: "orders",
+		}
+		if i%2 == 0 {
+			doc.Doc.UintProps = append(doc.Doc.UintProps, &cql.UintProp{Name: "date", ValLen: 8, Val: uint64(i)})
+		}
+		err = ir.Insert(doc)
+		require.NoError(t, err)
+		//the given document is kept unchanged
+		require.Equal(t, 1, len(doc.Doc.StrProps))
+	}
+
+	//TESTCASE: absent properties are indexed with the default values, and the original document is stored
+	cs := &cql.CqlSelect{
+		Index: "orders",
+		UintPreds: map[string]cql.UintPred{
+			"price": cql.UintPred{
+				Name: "price",
+				Low:  7,
+				High: 7,
+			},
+		},
+		StrPreds: map[string]cql.StrPred{
+			"note": cql.StrPred{
+				Name:     "note",
+				ContWord: "none",
+			},
+		},
+	}
+	qr, err = ir.Select(cs)
+	require.NoError(t, err)
+	require.Equal(t, uint64(initialNumDocs), qr.Bm.Count())
+	doc, found, err = ir.Get("orders", 3)
+	require.NoError(t, err)
+	require.Equal(t, true, found)
+	require.Equal(t, 1, len(doc.UintProps))
+	require.Equal(t, 1, len(doc.StrProps))
+	require.Equal(t, "order 003", doc.StrProps[0].Val)
+
+	//TESTCASE: EXISTS and IS NULL
+	cs = &cql.CqlSelect{
+		Index: "orders",
+		ExistPreds: map[string]cql.ExistPred{
+			"date": cql.ExistPred{
+				Name:   "date",
+				Exists: true,
+			},
+			"price": cql.ExistPred{
+				Name:   "price",
+				Exists: true,
+			},
+		},
+	}
+	qr, err = ir.Select(cs)
+	require.NoError(t, err)
+	require.Equal(t, uint64((initialNumDocs+1)/2), qr.Bm.Count())
+	cs.ExistPreds = map[string]cql.ExistPred{
+		"date": cql.ExistPred{
+			Name:   "date",
+			Exists: false,
+		},
+		"number": cql.ExistPred{
+			Name:   "number",
+			Exists: false,
+		},
+	}
+	qr, err = ir.Select(cs)
+	require.NoError(t, err)
+	require.Equal(t, uint64(initialNumDocs/2), qr.Bm.Count())
+
+	//TESTCASE: a document reinserted without a property after deletion IS NULL on it
+	found, err = ir.Del("orders", 0)
+	require.NoError(t, err)
+	require.Equal(t, true, found)
+	err = ir.Insert(&cql.DocumentWithIdx{
+		Doc: cql.Document{
+			DocID: 0,
+			UintProps: []*cql.UintProp{
+				&cql.UintProp{
+					Name:   "object",
+					ValLen: 8,
+					Val:    0,
+				},
+			},
+		},
+		Index: "orders",
+	})
+	require.NoError(t, err)
+	qr, err = ir.Select(cs)
+	require.NoError(t, err)
+	require.Equal(t, uint64(initialNumDocs/2+1), qr.Bm.Count())
+	require.Equal(t, uint64(0), qr.Bm.Bits()[0])
+
+	cs.ExistPreds = map[string]cql.ExistPred{
+		"unknown": cql.ExistPred{
+			Name:   "unknown",
+			Exists: false,
+		},
+	}
+	_, err = ir.Select(cs)
+	require.Equal(t, ErrUnknownProp, errors.Cause(err))
+	err = ir.Close()
+	require.NoError(t, err)
+}
+
+func TestIndexerBatch(t *testing.T) {
+	var err error
+	var ir, ir2 *Indexer
+	var qr *QueryResult
+	var errs []error
+	var founds []bool
+	initialNumDocs := 137
+
+	//create empty indexer with WAL
+	ir, err = NewIndexer("/tmp/indexer_test", true, true)
+	require.NoError(t, err)
+	err = ir.CreateIndex(newDocProt1())
+	require.NoError(t, err)
+
+	//TESTCASE: insert a batch which contains a duplicated document and a document of unknown index
+	docs := make([]*cql.DocumentWithIdx, 0)
+	for i := 0; i < initialNumDocs; i++ {
+		doc := newDocProt1()
+		doc.Doc.DocID = uint64(i)
+		for j := 0; j < len(doc.Doc.UintProps); j++ {
+			doc.Doc.UintProps[j].Val = uint64(i * (j + 1))
+		}
+		docs = append(docs, doc)
+	}
+	docs = append(docs, docs[0], newDocProt2())
+	errs, err = ir.InsertBatch(docs)
+	require.NoError(t, err)
+	require.Equal(t, len(docs), len(errs))
+	for i := 0; i < initialNumDocs; i++ {
+		require.NoError(t, errs[i])
+	}
+	require.Equal(t, ErrDocExist, errors.Cause(errs[initialNumDocs]))
+	require.Equal(t, ErrIdxNotExist, errors.Cause(errs[initialNumDocs+1]))
+
+	//TESTCASE: delete a batch which contains a missing document
+	dels := []*cql.DocumentDel{
+		&cql.DocumentDel{Index: "orders", DocID: 0},
+		&cql.DocumentDel{Index: "orders", DocID: 1},
+		&cql.DocumentDel{Index: "orders", DocID: 1},
+		&cql.DocumentDel{Index: "addrs", DocID: 2},
+	}
+	founds, errs, err = ir.DelBatch(dels)
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, true, false, false}, founds)
+	require.NoError(t, errs[0])
+	require.NoError(t, errs[2])
+	require.Equal(t, ErrIdxNotExist, errors.Cause(errs[3]))
+
+	//TESTCASE: batches survive WAL replay
+	err = ir.Close()
+	require.NoError(t, err)
+	ir2, err = NewIndexer("/tmp/indexer_test", false, true)
+	require.NoError(t, err)
+	cs := &cql.CqlSelect{
+		Index: "orders",
+		UintPreds: map[string]cql.UintPred{
+			"price": cql.UintPred{
+				Name: "price",
+				Low:  0,
+				High: ^uint64(0),
+			},
+		},
+	}
+	qr, err = ir2.Select(cs)
+	require.NoError(t, err)
+	require.Equal(t, uint64(initialNumDocs-2), qr.Bm.Count())
+	err = ir2.Close()
+	require.NoError(t, err)
+}
+
+func TestIndexerAppliedIndex(t *testing.T) {
+	var err error
+	var ir *Indexer
+	initialNumDocs := 37
+
+	ir, err = NewIndexer("/tmp/indexer_test", true, true)
+	require.NoError(t, err)
+	err = ir.CreateIndex(newDocProt1())
+	require.NoError(t, err)
+	insertDoc := func(docID int) {
+		doc := newDocProt1()
+		doc.Doc.DocID = uint64(docID)
+		for j := 0; j < len(doc.Doc.UintProps); j++ {
+			doc.Doc.UintProps[j].Val = uint64(docID * (j + 1))
+		}
+		err = ir.Insert(doc)
+		require.NoError(t, err)
+	}
+	for i := 0; i < initialNumDocs; i++ {
+		insertDoc(i)
+	}
+	require.Equal(t, uint64(initialNumDocs+1), ir.AppliedIndex())
+	dels := []*cql.DocumentDel{{Index: "orders", DocID: 0}, {Index: "orders", DocID: 1}}
+	_, _, err = ir.DelBatch(dels)
+	require.NoError(t, err)
+	require.Equal(t, uint64(initialNumDocs+2), ir.AppliedIndex())
+
+	//TESTCASE: the applied index survives a clean restart which removes WAL
+	err = ir.Close()
+	require.NoError(t, err)
+	ir, err = NewIndexer("/tmp/indexer_test", false, true)
+	require.NoError(t, err)
+	require.Equal(t, uint64(initialNumDocs+2), ir.AppliedIndex())
+	insertDoc(initialNumDocs)
+	require.Equal(t, uint64(initialNumDocs+3), ir.AppliedIndex())
+
+	//TESTCASE: the applied index is recovered from WAL after a crash
+	err = ir.Sync()
+	require.NoError(t, err)
+	insertDoc(initialNumDocs + 1)
+	insertDoc(initialNumDocs + 2)
+	for _, ind := range ir.indices {
+		err = ind.Close()
+		require.NoError(t, err)
+	}
+	err = ir.w.Close(false)
+	require.NoError(t, err)
+	ir, err = NewIndexer("/tmp/indexer_test", false, true)
+	require.NoError(t, err)
+	require.Equal(t, uint64(initialNumDocs+5), ir.AppliedIndex())
+	insertDoc(initialNumDocs + 3)
+	require.Equal(t, uint64(initialNumDocs+6), ir.AppliedIndex())
+
+	//TESTCASE: overwriting resets the applied index
+	err = ir.Close()
+	require.NoError(t, err)
+	ir, err = NewIndexer("/tmp/indexer_test", true, true)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), ir.AppliedIndex())
+	err = ir.Close()
+	require.NoError(t, err)
+}
+
+func TestIndexerApply(t *testing.T) {
+	var err error
+	var ir, ir2 *Indexer
+	var applied bool
+	var data []byte
+	snapDir := "/tmp/indexer_test_snap"
+
+	ir, err = NewIndexer("/tmp/indexer_test", true, true)
+	require.NoError(t, err)
+	//the log may contain entries which aren't for the indexer, so indices could be sparse
+	data, err = newDocProt1().Marshal()
+	require.NoError(t, err)
+	applied, err = ir.Apply(1, 3, &walpb.Entry{Type: EntryCreateIndex, Data: data})
+	require.NoError(t, err)
+	require.Equal(t, true, applied)
+	require.NotNil(t, ir.GetDocProt("orders"))
+	for i := 0; i < 10; i++ {
+		doc := newDocProt1()
+		doc.Doc.DocID = uint64(i)
+		data, err = doc.Marshal()
+		require.NoError(t, err)
+		applied, err = ir.Apply(1, uint64(5+i), &walpb.Entry{Type: EntryInsert, Data: data})
+		require.NoError(t, err)
+		require.Equal(t, true, applied)
+	}
+	require.Equal(t, uint64(14), ir.AppliedIndex())
+
+	//TESTCASE: re-applying an applied entry is a no-op
+	data, err = (&cql.DocumentDel{Index: "orders", DocID: 0}).Marshal()
+	require.NoError(t, err)
+	applied, err = ir.Apply(1, 14, &walpb.Entry{Type: EntryDelete, Data: data})
+	require.NoError(t, err)
+	require.Equal(t, false, applied)
+	applied, err = ir.Apply(2, 15, &walpb.Entry{Type: EntryDelete, Data: data})
+	require.NoError(t, err)
+	require.Equal(t, true, applied)
+
+	//TESTCASE: the snapshot records the applied index
+	_, err = ir.CreateSnapshot(snapDir)
+	require.NoError(t, err)
+	ir2, err = NewIndexer("/tmp/indexer_test2", true, true)
+	require.NoError(t, err)
+	err = ir2.ApplySnapshot(snapDir)
+	require.NoError(t, err)
+	require.Equal(t, uint64(15), ir2.AppliedIndex())
+	applied, err = ir2.Apply(2, 15, &walpb.Entry{Type: EntryDelete, Data: data})
+	require.NoError(t, err)
+	require.Equal(t, false, applied)
+	err = ir2.Close()
+	require.NoError(t, err)
+
+	//TESTCASE: the log is replayed after a crash, and destroying index is applied
+	applied, err = ir.Apply(2, 16, &walpb.Entry{Type: EntryDestroyIndex, Data: []byte("orders")})
+	require.NoError(t, err)
+	require.Equal(t, true, applied)
+	err = ir.w.Close(false)
+	require.NoError(t, err)
+	ir, err = NewIndexer("/tmp/indexer_test", false, true)
+	require.NoError(t, err)
+	require.Equal(t, uint64(16), ir.AppliedIndex())
+	require.Nil(t, ir.GetDocProt("orders"))
+	err = ir.Close()
+	require.NoError(t, err)
+
+	//TESTCASE: without WAL, only Apply advances the applied index, which is persisted at close
+	ir, err = NewIndexer("/tmp/indexer_test", true, false)
+	require.NoError(t, err)
+	data, err = newDocProt1().Marshal()
+	require.NoError(t, err)
+	applied, err = ir.Apply(1, 3, &walpb.Entry{Type: EntryCreateIndex, Data: data})
+	require.NoError(t, err)
+	require.Equal(t, true, applied)
+	require.Equal(t, uint64(3), ir.AppliedIndex())
+	doc := newDocProt1()
+	doc.Doc.DocID = 1
+	err = ir.Insert(doc)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), ir.AppliedIndex())
+	err = ir.Close()
+	require.NoError(t, err)
+	ir, err = NewIndexer("/tmp/indexer_test", false, false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), ir.AppliedIndex())
+	applied, err = ir.Apply(1, 3, &walpb.Entry{Type: EntryCreateIndex, Data: data})
+	require.NoError(t, err)
+	require.Equal(t, false, applied)
+	err = ir.Close()
+	require.NoError(t, err)
+}
+
+func TestIndexerWalSchemaOps(t *testing.T) {
+	var err error
+	var ir *Indexer
+	var cnt uint64
+	initialNumDocs := 17
+
+	ir, err = NewIndexer("/tmp/indexer_test", true, true)
+	require.NoError(t, err)
+	err = ir.CreateIndex(newDocProt1())
+	require.NoError(t, err)
+	for i := 0; i < initialNumDocs; i++ {
+		doc := newDocProt1()
+		doc.Doc.DocID = uint64(i)
+		err = ir.Insert(doc)
+		require.NoError(t, err)
+	}
+	err = ir.DestroyIndex("orders")
+	require.NoError(t, err)
+	err = ir.CreateIndex(newDocProt2())
+	require.NoError(t, err)
+	for i := 0; i < initialNumDocs; i++ {
+		doc := newDocProt2()
+		doc.Doc.DocID = uint64(i)
+		err = ir.Insert(doc)
+		require.NoError(t, err)
+	}
+	//creating an index with the same schema isn't logged
+	err = ir.CreateIndex(newDocProt2())
+	require.NoError(t, err)
+	require.Equal(t, uint64(2*initialNumDocs+3), ir.AppliedIndex())
+
+	//TESTCASE: schema operations are replayed in order after a crash
+	for _, ind := range ir.indices {
+		err = ind.Close()
+		require.NoError(t, err)
+	}
+	err = ir.w.Close(false)
+	require.NoError(t, err)
+	ir, err = NewIndexer("/tmp/indexer_test", false, true)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2*initialNumDocs+3), ir.AppliedIndex())
+	require.Nil(t, ir.GetDocProt("orders"))
+	require.NotNil(t, ir.GetDocProt("addrs"))
+	cnt, err = ir.indices["addrs"].liveDocs.Count()
+	require.NoError(t, err)
+	require.Equal(t, uint64(initialNumDocs), cnt)
+	err = ir.Close()
+	require.NoError(t, err)
+}
+
+func TestIndexerWalEntryTypes(t *testing.T) {
+	var err error
+	var ir *Indexer
+	var qr *QueryResult
+
+	ir, err = NewIndexer("/tmp/indexer_test", true, true)
+	require.NoError(t, err)
+	//Update takes the text terms of the old document from the document store
+	docProt := newDocProt1()
+	docProt.StoreDoc = true
+	err = ir.CreateIndex(docProt)
+	require.NoError(t, err)
+	docs := make([]*cql.DocumentWithIdx, 0)
+	for i := 0; i < 3; i++ {
+		doc := newDocProt1()
+		doc.Doc.DocID = uint64(i)
+		doc.Doc.UintProps[1].Val = uint64(i)
+		docs = append(docs, doc)
+	}
+	err = ir.Insert(docs[0])
+	require.NoError(t, err)
+	_, err = ir.InsertBatch(docs[1:])
+	require.NoError(t, err)
+	doc := newDocProt1()
+	doc.Doc.UintProps[1].Val = 100
+	err = ir.Update(doc)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), ir.AppliedIndex())
+
+	//TESTCASE: updates and batches are replayed after a crash, over the documents persisted before it
+	for _, ind := range ir.indices {
+		err = ind.Close()
+		require.NoError(t, err)
+	}
+	err = ir.w.Close(false)
+	require.NoError(t, err)
+	ir, err = NewIndexer("/tmp/indexer_test", false, true)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), ir.AppliedIndex())
+	cs := &cql.CqlSelect{
+		Index: "orders",
+		UintPreds: map[string]cql.UintPred{
+			"price": cql.UintPred{
+				Name: "price",
+				Low:  0,
+				High: 10,
+			},
+		},
+	}
+	qr, err = ir.Select(cs)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, qr.Bm.Bits())
+
+	//TESTCASE: unknown entry types and envelope versions are rejected
+	err = ir.applyEntry(&walpb.Entry{Type: walpb.EntryType(100)})
+	require.Equal(t, ErrUnknownEntryType, errors.Cause(err))
+	var data []byte
+	data, err = (&walpb.Envelope{Version: walpb.EnvelopeVersion + 1}).Marshal()
+	require.NoError(t, err)
+	_, err = walpb.UnwrapPayload(data)
+	require.Equal(t, walpb.ErrUnknownVersion, errors.Cause(err))
+
+	//TESTCASE: entries written before the envelope was introduced are replayed as bare payloads
+	doc = newDocProt1()
+	doc.Doc.DocID = 10
+	doc.Doc.UintProps[1].Val = 10
+	data, err = doc.Marshal()
+	require.NoError(t, err)
+	ents := []walpb.Entry{{Type: EntryInsert, Data: data}}
+	data, err = (&cql.DocumentDel{Index: "orders", DocID: 1}).Marshal()
+	require.NoError(t, err)
+	ents = append(ents, walpb.Entry{Type: EntryDelete, Data: data})
+	_, err = ir.w.Append(ents)
+	require.NoError(t, err)
+	for _, ind := range ir.indices {
+		err = ind.Close()
+		require.NoError(t, err)
+	}
+	err = ir.w.Close(false)
+	require.NoError(t, err)
+	ir, err = NewIndexer("/tmp/indexer_test", false, true)
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), ir.AppliedIndex())
+	qr, err = ir.Select(cs)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 10}, qr.Bm.Bits())
+
+	err = ir.Close()
+	require.NoError(t, err)
+}
+
+func TestIndexerCheckpoint(t *testing.T) {
+	var err error
+	var ir *Indexer
+	var cnt uint64
+	numDocs := 200
+
+	ir, err = NewIndexer("/tmp/indexer_test", true, true)
+	require.NoError(t, err)
+	err = ir.CreateIndex(newDocProt1())
+	require.NoError(t, err)
+
+	//TESTCASE: checkpoints run concurrently with insertions
+	done := make(chan error)
+	go func() {
+		var err2 error
+		for i := 0; i < numDocs; i++ {
+			doc := newDocProt1()
+			doc.Doc.DocID = uint64(i)
+			if err2 = ir.Insert(doc); err2 != nil {
+				break
+			}
+		}
+		done <- err2
+	}()
+	for i := 0; i < 5; i++ {
+		err = ir.Checkpoint()
+		require.NoError(t, err)
+	}
+	err = <-done
+	require.NoError(t, err)
+	err = ir.Checkpoint()
+	require.NoError(t, err)
+	require.Equal(t, uint64(numDocs+1), ir.AppliedIndex())
+
+	//TESTCASE: entries after the checkpoint are replayed after a crash
+	doc := newDocProt1()
+	doc.Doc.DocID = uint64(numDocs)
+	err = ir.Insert(doc)
+	require.NoError(t, err)
+	for _, ind := range ir.indices {
+		err = ind.Close()
+		require.NoError(t, err)
+	}
+	err = ir.w.Close(false)
+	require.NoError(t, err)
+	ir, err = NewIndexer("/tmp/indexer_test", false, true)
+	require.NoError(t, err)
+	require.Equal(t, uint64(numDocs+2), ir.AppliedIndex())
+	cnt, err = ir.indices["orders"].liveDocs.Count()
+	require.NoError(t, err)
+	require.Equal(t, uint64(numDocs+1), cnt)
+	err = ir.Close()
+	require.NoError(t, err)
+}
+
+func TestIndexerRepairWal(t *testing.T) {
+	var err error
+	var ir *Indexer
+	var names []string
+	var before, after []byte
+	walDir := "/tmp/indexer_test/wal"
+
+	ir, err = NewIndexer("/tmp/indexer_test", true, true)
+	require.NoError(t, err)
+	err = ir.CreateIndex(newDocProt1())
+	require.NoError(t, err)
+	insertDoc := func(docID uint64) {
+		doc := newDocProt1()
+		doc.Doc.DocID = docID
+		doc.Doc.StrProps[0].Val = strings.Repeat("torn write ", 400)
+		err = ir.Insert(doc)
+		require.NoError(t, err)
+	}
+	insertDoc(0)
+	names, err = filepath.Glob(filepath.Join(walDir, "*.wal"))
+	require.NoError(t, err)
+	sort.Strings(names)
+	tail := names[len(names)-1]
+	before, err = ioutil.ReadFile(tail)
+	require.NoError(t, err)
+	insertDoc(1)
+	after, err = ioutil.ReadFile(tail)
+	require.NoError(t, err)
+	for _, ind := range ir.indices {
+		err = ind.Close()
+		require.NoError(t, err)
+	}
+	err = ir.w.Close(false)
+	require.NoError(t, err)
+
+	// zero a sector inside the last entry to simulate a torn write
+	start := 0
+	for before[start] == after[start] {
+		start++
+	}
+	off := (start/512 + 2) * 512
+	copy(after[off:off+512], make([]byte, 512))
+	err = ioutil.WriteFile(tail, after, 0600)
+	require.NoError(t, err)
+
+	//TESTCASE: replay fails without repairing
+	ir, err = NewIndexer("/tmp/indexer_test", false, true)
+	require.Equal(t, io.ErrUnexpectedEOF, errors.Cause(err))
+	err = ir.Close()
+	require.NoError(t, err)
+
+	//TESTCASE: replay succeeds after repairing, and the torn entry is lost
+	ir, err = NewIndexerExt("/tmp/indexer_test", IndexerOptions{EnableWal: true, RepairWal: true})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), ir.AppliedIndex())
+	err = ir.Close()
+	require.NoError(t, err)
+}
+
+func TestIndexerIncrementalSnapshot(t *testing.T) {
+	var err error
+	var ir, ir2 *Indexer
+	var manifest1, manifest2 *SnapshotManifest
+	mainDir1 := "/tmp/indexer_test"
+	mainDir2 := "/tmp/indexer_test2"
+	snapDir1 := "/tmp/indexer_test_snap"
+	snapDir2 := "/tmp/indexer_test_snap2"
+	insertOrders := func(ir *Indexer, begin, end int) {
+		for i := begin; i < end; i++ {
+			doc := newDocProt1()
+			doc.Doc.DocID = uint64(i)
+			for j := 0; j < len(doc.Doc.UintProps); j++ {
+				doc.Doc.UintProps[j].Val = uint64(i * (j + 1))
+			}
+			err = ir.Insert(doc)
+			require.NoError(t, err)
+		}
+	}
+
+	ir, err = NewIndexer(mainDir1, true, false)
+	require.NoError(t, err)
+	err = ir.CreateIndex(newDocProt1())
+	require.NoError(t, err)
+	err = ir.CreateIndex(newDocProt2())
+	require.NoError(t, err)
+	insertOrders(ir, 0, 10)
+	err = ir.Insert(newDocProt2())
+	require.NoError(t, err)
+
+	//TESTCASE: fragments of a full snapshot are hard-linked
+	manifest1, _, err = ir.CreateSnapshotExt(snapDir1, SnapshotOptions{})
+	require.NoError(t, err)
+	require.NotEqual(t, 0, len(manifest1.Fragments))
+	require.Equal(t, 0, len(manifest1.Omitted))
+	liveFrag := filepath.Join(LiveDocs, "fragments", "0")
+	require.True(t, isLinked(filepath.Join(mainDir1, "orders", liveFrag)))
+
+	//TESTCASE: a linked fragment is not changed by the following modifications
+	insertOrders(ir, 10, 20)
+	require.False(t, isLinked(filepath.Join(mainDir1, "orders", liveFrag)))
+	sum, err := fileChecksum(filepath.Join(snapDir1, "index", "orders", liveFrag))
+	require.NoError(t, err)
+	require.Equal(t, manifest1.Fragments[filepath.Join("orders", liveFrag)], sum)
+
+	//TESTCASE: an incremental snapshot omits the unchanged fragments
+	manifest2, _, err = ir.CreateSnapshotExt(snapDir2, SnapshotOptions{Base: manifest1})
+	require.NoError(t, err)
+	require.Equal(t, len(manifest1.Fragments), len(manifest2.Fragments))
+	require.NotEqual(t, 0, len(manifest2.Omitted))
+	for _, rel := range manifest2.Omitted {
+		require.True(t, strings.HasPrefix(rel, "addrs"))
+	}
+	require.NotEqual(t, manifest1.Fragments[filepath.Join("orders", liveFrag)], manifest2.Fragments[filepath.Join("orders", liveFrag)])
+
+	//TESTCASE: an incremental snapshot shall be completed before applying
+	ir2, err = NewIndexer(mainDir2, true, false)
+	require.NoError(t, err)
+	err = ir2.ApplySnapshot(snapDir2)
+	require.Equal(t, ErrIncompleteSnapshot, errors.Cause(err))
+	err = CompleteSnapshot(snapDir2, snapDir1)
+	require.NoError(t, err)
+	err = ir2.ApplySnapshot(snapDir2)
+	require.NoError(t, err)
+	cs := &cql.CqlSelect{
+		Index: "orders",
+		UintPreds: map[string]cql.UintPred{
+			"price": cql.UintPred{
+				Name: "price",
+				Low:  0,
+				High: 1000,
+			},
+		},
+		Limit: 100,
+	}
+	qr, err := ir2.Select(cs)
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), qr.Bm.Count())
+
+	err = ir.Close()
+	require.NoError(t, err)
+	err = ir2.Close()
+	require.NoError(t, err)
+}
+
+func TestIndexerStreamSnapshot(t *testing.T) {
+	var err error
+	var ir, ir2 *Indexer
+	var buf bytes.Buffer
+	initialNumDocs := 37
+
+	ir, err = NewIndexer("/tmp/indexer_test", true, true)
+	require.NoError(t, err)
+	err = ir.CreateIndex(newDocProt1())
+	require.NoError(t, err)
+	for i := 0; i < initialNumDocs; i++ {
+		doc := newDocProt1()
+		doc.Doc.DocID = uint64(i)
+		doc.Doc.StrProps[0].Val = fmt.Sprintf("description of order %d", i)
+		err = ir.Insert(doc)
+		require.NoError(t, err)
+	}
+	err = ir.WriteSnapshot(&buf)
+	require.NoError(t, err)
+	archive := buf.Bytes()
+	countOrders := func(ir *Indexer) uint64 {
+		qr, err := ir.Select(&cql.CqlSelect{
+			Index: "orders",
+			StrPreds: map[string]cql.StrPred{
+				"description": cql.StrPred{
+					Name:     "description",
+					ContWord: "description",
+				},
+			},
+			Limit: 100,
+		})
+		require.NoError(t, err)
+		return qr.Bm.Count()
+	}
+
+	//TESTCASE: the archive is installed
+	ir2, err = NewIndexer("/tmp/indexer_test2", true, true)
+	require.NoError(t, err)
+	err = ir2.ReadSnapshot(bytes.NewReader(archive))
+	require.NoError(t, err)
+	require.Equal(t, ir.AppliedIndex(), ir2.AppliedIndex())
+	require.Equal(t, uint64(initialNumDocs), countOrders(ir2))
+
+	//TESTCASE: a truncated archive is rejected, and the indices are intact
+	err = ir2.ReadSnapshot(bytes.NewReader(archive[:len(archive)/2]))
+	require.Error(t, err)
+	require.Equal(t, uint64(initialNumDocs), countOrders(ir2))
+
+	//TESTCASE: an archive whose entry is corrupted is rejected
+	var corrupted bytes.Buffer
+	tr := tar.NewReader(bytes.NewReader(archive))
+	tw := tar.NewWriter(&corrupted)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(tr)
+		require.NoError(t, err)
+		if strings.HasSuffix(hdr.Name, "fragments/0") && len(data) != 0 {
+			data[len(data)-1] ^= 0xff
+		}
+		err = tw.WriteHeader(hdr)
+		require.NoError(t, err)
+		_, err = tw.Write(data)
+		require.NoError(t, err)
+	}
+	err = tw.Close()
+	require.NoError(t, err)
+	err = ir2.ReadSnapshot(&corrupted)
+	require.Equal(t, ErrChecksumMismatch, errors.Cause(err))
+	require.Equal(t, uint64(initialNumDocs), countOrders(ir2))
+
+	err = ir.Close()
+	require.NoError(t, err)
+	err = ir2.Close()
+	require.NoError(t, err)
+}
+
+func TestIndexerApplySnapshotRollback(t *testing.T) {
+	var err error
+	var ir, ir2 *Indexer
+	var found bool
+	mainDir1 := "/tmp/indexer_test"
+	mainDir2 := "/tmp/indexer_test2"
+	snapDir := "/tmp/indexer_test_snap"
+
+	ir, err = NewIndexer(mainDir1, true, false)
+	require.NoError(t, err)
+	err = ir.CreateIndex(newDocProt1())
+	require.NoError(t, err)
+	ir2, err = NewIndexer(mainDir2, true, false)
+	require.NoError(t, err)
+	docProt := newDocProt2()
+	docProt.StoreDoc = true
+	err = ir2.CreateIndex(docProt)
+	require.NoError(t, err)
+	err = ir2.Insert(newDocProt2())
+	require.NoError(t, err)
+
+	//TESTCASE: a corrupted snapshot is rejected, and the previous state is intact
+	_, err = ir.CreateSnapshot(snapDir)
+	require.NoError(t, err)
+	err = ioutil.WriteFile(filepath.Join(snapDir, "index", "index_orders.json"), []byte("{}"), 0600)
+	require.NoError(t, err)
+	err = ir2.ApplySnapshot(snapDir)
+	require.Equal(t, ErrChecksumMismatch, errors.Cause(err))
+	_, found, err = ir2.Get("addrs", 0)
+	require.NoError(t, err)
+	require.True(t, found)
+	_, err = os.Stat(mainDir2 + stagedDirSuffix)
+	require.True(t, os.IsNotExist(err))
+
+	//TESTCASE: a valid snapshot replaces the previous state
+	_, err = ir.CreateSnapshot(snapDir)
+	require.NoError(t, err)
+	err = ir2.ApplySnapshot(snapDir)
+	require.NoError(t, err)
+	_, found = ir2.docProts["orders"]
+	require.True(t, found)
+	_, found = ir2.docProts["addrs"]
+	require.False(t, found)
+	_, err = os.Stat(mainDir2 + previousDirSuffix)
+	require.True(t, os.IsNotExist(err))
+	err = ir2.Close()
+	require.NoError(t, err)
+
+	//TESTCASE: an apply interrupted between the renames is rolled back at start
+	err = os.Rename(mainDir2, mainDir2+previousDirSuffix)
+	require.NoError(t, err)
+	ir2, err = NewIndexer(mainDir2, false, false)
+	require.NoError(t, err)
+	_, found = ir2.docProts["orders"]
+	require.True(t, found)
+
+	err = ir.Close()
+	require.NoError(t, err)
+	err = ir2.Close()
+	require.NoError(t, err)
+}
+
+func TestIndexerRestoreIndex(t *testing.T) {
+	var err error
+	var ir *Indexer
+	var manifest *SnapshotManifest
+	mainDir := "/tmp/indexer_test"
+	snapDir := "/tmp/indexer_test_snap"
+	insertOrders := func(begin, end int) {
+		for i := begin; i < end; i++ {
+			doc := newDocProt1()
+			doc.Doc.DocID = uint64(i)
+			err = ir.Insert(doc)
+			require.NoError(t, err)
+		}
+	}
+	countDocs := func(name string) uint64 {
+		ind, found := ir.indices[name]
+		require.True(t, found)
+		cnt, err := ind.liveDocs.Count()
+		require.NoError(t, err)
+		return cnt
+	}
+
+	ir, err = NewIndexer(mainDir, true, true)
+	require.NoError(t, err)
+	err = ir.CreateIndex(newDocProt1())
+	require.NoError(t, err)
+	err = ir.CreateIndex(newDocProt2())
+	require.NoError(t, err)
+	insertOrders(0, 10)
+
+	//TESTCASE: the snapshot contains only the given index
+	manifest, err = ir.SnapshotIndex("orders", snapDir)
+	require.NoError(t, err)
+	require.NotEqual(t, 0, len(manifest.Fragments))
+	for rel := range manifest.Files {
+		require.True(t, rel == "index_orders.json" || strings.HasPrefix(rel, "orders"+string(filepath.Separator)), rel)
+	}
+	for rel := range manifest.Fragments {
+		require.True(t, strings.HasPrefix(rel, "orders"+string(filepath.Separator)), rel)
+	}
+
+	//TESTCASE: restoring an index doesn't touch the other ones
+	insertOrders(10, 20)
+	err = ir.Insert(newDocProt2())
+	require.NoError(t, err)
+	err = ir.RestoreIndex("orders", snapDir, "orders")
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), countDocs("orders"))
+	require.Equal(t, uint64(1), countDocs("addrs"))
+	_, err = os.Stat(mainDir + restoringDirSuffix)
+	require.True(t, os.IsNotExist(err))
+
+	//TESTCASE: an index can be restored as a new one
+	err = ir.RestoreIndex("orders", snapDir, "orders_copy")
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), countDocs("orders_copy"))
+	require.Equal(t, "orders_copy", ir.docProts["orders_copy"].Index)
+
+	//TESTCASE: the earlier WAL entries are not replayed onto the restored index
+	err = ir.Close()
+	require.NoError(t, err)
+	ir, err = NewIndexer(mainDir, false, true)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), countDocs("orders"))
+	require.Equal(t, uint64(10), countDocs("orders_copy"))
+	require.Equal(t, uint64(1), countDocs("addrs"))
+
+	//TESTCASE: restoring a nonexistent index fails
+	err = ir.RestoreIndex("users", snapDir, "users")
+	require.Error(t, err)
+
+	//TESTCASE: a restoration interrupted between the renames is rolled back at start
+	err = ir.Close()
+	require.NoError(t, err)
+	err = os.MkdirAll(filepath.Join(mainDir+restoringDirSuffix, "orders"), 0700)
+	require.NoError(t, err)
+	err = os.Rename(filepath.Join(mainDir, "orders"), filepath.Join(mainDir+restoringDirSuffix, "orders"+previousDirSuffix))
+	require.NoError(t, err)
+	ir, err = NewIndexer(mainDir, false, true)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), countDocs("orders"))
+	_, err = os.Stat(mainDir + restoringDirSuffix)
+	require.True(t, os.IsNotExist(err))
+
+	err = ir.Close()
+	require.NoError(t, err)
+}
+
+func TestIndexerUpdate(t *testing.T) {
+	var err error
+	var ir *Indexer
+	var qr *QueryResult
+
+	for _, storeDoc := range []bool{false, true} {
+		ir, err = NewIndexer("/tmp/indexer_test", true, false)
+		require.NoError(t, err)
+		docProt := newDocProt1()
+		docProt.StoreDoc = storeDoc
+		err = ir.CreateIndex(docProt)
+		require.NoError(t, err)
+		//TESTCASE: a document which isn't there is inserted
+		doc := newDocProt1()
+		doc.Doc.StrProps[0].Val = "red apple"
+		doc.Doc.StrProps[1].Val = "fresh"
+		err = ir.Update(doc)
+		require.NoError(t, err, "storeDoc %v", storeDoc)
+
+		if !storeDoc {
+			red := &cql.CqlSelect{
+				Index:    "orders",
+				StrPreds: map[string]cql.StrPred{"description": cql.StrPred{Name: "description", ContWord: "red"}},
+			}
+			//TESTCASE: the text terms of a live document are unknown without the document store
+			err = ir.Update(newDocProt1())
+			require.Equal(t, ErrNoDocStore, errors.Cause(err))
+			qr, err = ir.Select(red)
+			require.NoError(t, err)
+			require.Equal(t, []uint64{0}, qr.Bm.Bits())
+
+			//TESTCASE: an insertion replayed over the live document replaces it with itself
+			var data []byte
+			data, err = doc.Marshal()
+			require.NoError(t, err)
+			err = ir.applyEntry(&walpb.Entry{Type: EntryInsert, Data: data})
+			require.NoError(t, err)
+			qr, err = ir.Select(red)
+			require.NoError(t, err)
+			require.Equal(t, []uint64{0}, qr.Bm.Bits())
+			err = ir.Close()
+			require.NoError(t, err)
+			continue
+		}
+
+		//TESTCASE: a word removed by the update no longer matches
+		doc = newDocProt1()
+		doc.Doc.StrProps = doc.Doc.StrProps[:1]
+		doc.Doc.StrProps[0].Val = "green apple"
+		err = ir.Update(doc)
+		require.NoError(t, err)
+		cs := &cql.CqlSelect{
+			Index: "orders",
+			StrPreds: map[string]cql.StrPred{
+				"description": cql.StrPred{
+					Name:     "description",
+					ContWord: "red",
+				},
+			},
+		}
+		qr, err = ir.Select(cs)
+		require.NoError(t, err)
+		require.Equal(t, uint64(0), qr.Bm.Count(), "storeDoc %v", storeDoc)
+		cs.StrPreds["description"] = cql.StrPred{Name: "description", ContWord: "apple"}
+		qr, err = ir.Select(cs)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{0}, qr.Bm.Bits())
+
+		//TESTCASE: a property removed by the update IS NULL and its words no longer match
+		cs.StrPreds = map[string]cql.StrPred{
+			"note": cql.StrPred{
+				Name:     "note",
+				ContWord: "fresh",
+			},
+		}
+		qr, err = ir.Select(cs)
+		require.NoError(t, err)
+		require.Equal(t, uint64(0), qr.Bm.Count(), "storeDoc %v", storeDoc)
+		cs.StrPreds = nil
+		cs.ExistPreds = map[string]cql.ExistPred{
+			"note": cql.ExistPred{
+				Name:   "note",
+				Exists: false,
+			},
+		}
+		qr, err = ir.Select(cs)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{0}, qr.Bm.Bits())
+		err = ir.Close()
+		require.NoError(t, err)
+	}
+}
+
 func TestIndexerOpenClose(t *testing.T) {
 	var err error
 	var ir *Indexer
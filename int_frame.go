@@ -25,8 +25,9 @@ type IntFrame struct {
 	index     string
 	name      string
 	bitDepth  uint
-	rwlock    sync.RWMutex                //concurrent access of fragments
+	rwlock    sync.RWMutex                //concurrent access of fragments, shared
 	fragments map[uint64]*pilosa.Fragment //map slice to Fragment
+	shared    map[uint64]bool             //slices whose fragment file is hard-linked to a snapshot
 }
 
 // NewIntFrame returns a new instance of frame, and initializes it.
@@ -43,6 +44,7 @@ func NewIntFrame(path, index, name string, bitDepth uint, overwrite bool) (f *In
 		name:      name,
 		bitDepth:  bitDepth,
 		fragments: make(map[uint64]*pilosa.Fragment),
+		shared:    make(map[uint64]bool),
 	}
 	err = f.openFragments()
 	return
@@ -72,6 +74,10 @@ func (f *IntFrame) openFragments() (err error) {
 		}
 		f.rwlock.Lock()
 		f.fragments[slice] = fragment
+		//the file could have been linked to a snapshot before the frame was closed
+		if isLinked(fp) {
+			f.shared[slice] = true
+		}
 		f.rwlock.Unlock()
 	}
 	return
@@ -108,6 +114,7 @@ func (f *IntFrame) closeFragments() (err error) {
 	}
 	f.rwlock.Lock()
 	f.fragments = nil
+	f.shared = nil
 	f.rwlock.Unlock()
 	return
 }
@@ -122,10 +129,47 @@ func (f *IntFrame) Sync() (err error) {
 			return
 		}
 	}
+	f.shared = make(map[uint64]bool)
 	f.rwlock.Unlock()
 	return
 }
 
+// linkFragments hard-links all fragment files into the fragments directory of dir, which shall be on the same
+// file system. A linked fragment is rewritten to a new file before its next modification, so the link never changes.
+// Fragments are copied instead if the link fails.
+func (f *IntFrame) linkFragments(dir string) (err error) {
+	fragDir := filepath.Join(dir, "fragments")
+	if err = os.MkdirAll(fragDir, 0700); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	f.rwlock.Lock()
+	defer f.rwlock.Unlock()
+	var linked bool
+	for slice := range f.fragments {
+		if linked, err = linkOrCopy(f.FragmentPath(slice), filepath.Join(fragDir, strconv.FormatUint(slice, 10))); err != nil {
+			return
+		}
+		if linked {
+			f.shared[slice] = true
+		}
+	}
+	return
+}
+
+// unshare rewrites the fragment of the given slice to a new file if it's hard-linked to a snapshot. f.rwlock shall be held.
+func (f *IntFrame) unshare(slice uint64, fragment *pilosa.Fragment) (err error) {
+	if !f.shared[slice] {
+		return
+	}
+	if err = fragment.Snapshot(); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	delete(f.shared, slice)
+	return
+}
+
 // FragmentPath returns the path to a fragment
 func (f *IntFrame) FragmentPath(slice uint64) string {
 	return filepath.Join(f.path, "fragments", strconv.FormatUint(slice, 10))
@@ -160,6 +204,10 @@ func (f *IntFrame) setValue(colID, val uint64) (changed bool, err error) {
 		}
 		f.fragments[slice] = fragment
 	}
+	if err = f.unshare(slice, fragment); err != nil {
+		f.rwlock.Unlock()
+		return
+	}
 	f.rwlock.Unlock()
 	changed, err = fragment.SetFieldValue(colID, f.bitDepth, val)
 	return
@@ -212,6 +260,21 @@ func (f *IntFrame) QueryRangeBetween(predicateMin, predicateMax uint64) (bm *pil
 	return
 }
 
+//queryRangeBetweenSlice is QueryRangeBetween limited to the given slice.
+func (f *IntFrame) queryRangeBetweenSlice(predicateMin, predicateMax, slice uint64) (bm *pilosa.Bitmap, err error) {
+	f.rwlock.RLock()
+	fragment, ok := f.fragments[slice]
+	f.rwlock.RUnlock()
+	if !ok {
+		bm = pilosa.NewBitmap()
+		return
+	}
+	if bm, err = fragment.FieldRangeBetween(f.bitDepth, predicateMin, predicateMax); err != nil {
+		err = errors.Wrap(err, "")
+	}
+	return
+}
+
 // GetFragList returns fragments' numbers
 func (f *IntFrame) GetFragList() (numList []uint64) {
 	numList = make([]uint64, len(f.fragments))
@@ -0,0 +1,198 @@
+package rpc
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/deepfabric/indexer"
+	"github.com/deepfabric/indexer/cql"
+	"github.com/deepfabric/indexer/rpc/rpcpb"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+//Client talks to an indexer process served by Server. The gRPC status of a failure is kept in errors.Cause of the returned error.
+type Client struct {
+	conn *grpc.ClientConn
+	c    rpcpb.IndexerClient
+}
+
+//Dial connects to the Server at addr. The connection is insecure unless opts are given.
+func Dial(addr string, opts ...grpc.DialOption) (c *Client, err error) {
+	if len(opts) == 0 {
+		opts = []grpc.DialOption{grpc.WithInsecure()}
+	}
+	var conn *grpc.ClientConn
+	if conn, err = grpc.Dial(addr, opts...); err != nil {
+		err = errors.Wrap(err, addr)
+		return
+	}
+	c = &Client{conn: conn, c: rpcpb.NewIndexerClient(conn)}
+	return
+}
+
+//Close closes the connection.
+func (c *Client) Close() (err error) {
+	if err = c.conn.Close(); err != nil {
+		err = errors.Wrap(err, "")
+	}
+	return
+}
+
+//CreateIndex creates an index.
+func (c *Client) CreateIndex(ctx context.Context, docProt *cql.DocumentWithIdx) (err error) {
+	if _, err = c.c.CreateIndex(ctx, docProt); err != nil {
+		err = errors.Wrap(err, "")
+	}
+	return
+}
+
+//DestroyIndex destroys an index.
+func (c *Client) DestroyIndex(ctx context.Context, name string) (err error) {
+	if _, err = c.c.DestroyIndex(ctx, &rpcpb.DestroyIndexRequest{Index: name}); err != nil {
+		err = errors.Wrap(err, "")
+	}
+	return
+}
+
+//Insert inserts a document.
+func (c *Client) Insert(ctx context.Context, doc *cql.DocumentWithIdx) (err error) {
+	if _, err = c.c.Insert(ctx, doc); err != nil {
+		err = errors.Wrap(err, "")
+	}
+	return
+}
+
+//InsertBatch streams docs to the server, which inserts them in batches.
+//errs[i] is the error of docs[i], or nil if it succeeded. err is the error of the stream or the server's WAL.
+func (c *Client) InsertBatch(ctx context.Context, docs []*cql.DocumentWithIdx) (errs []error, err error) {
+	var stream rpcpb.Indexer_InsertBatchClient
+	if stream, err = c.c.InsertBatch(ctx); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	for _, doc := range docs {
+		if err = stream.Send(doc); err != nil {
+			//the server has aborted the stream, and CloseAndRecv returns the reason.
+			if err == io.EOF {
+				break
+			}
+			err = errors.Wrap(err, "")
+			return
+		}
+	}
+	var resp *rpcpb.InsertBatchResponse
+	if resp, err = stream.CloseAndRecv(); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	if len(resp.Errors) != len(docs) {
+		err = errors.Errorf("got %d results of %d documents", len(resp.Errors), len(docs))
+		return
+	}
+	errs = make([]error, len(docs))
+	for i, msg := range resp.Errors {
+		if msg != "" {
+			errs[i] = errors.New(msg)
+		}
+	}
+	return
+}
+
+//Del deletes a document.
+func (c *Client) Del(ctx context.Context, name string, docID uint64) (found bool, err error) {
+	var resp *rpcpb.DelResponse
+	if resp, err = c.c.Del(ctx, &cql.DocumentDel{Index: name, DocID: docID}); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	found = resp.Found
+	return
+}
+
+//Select executes a query.
+func (c *Client) Select(ctx context.Context, q *cql.CqlSelect) (qr *indexer.QueryResult, err error) {
+	var resp *rpcpb.SelectResponse
+	if resp, err = c.c.Select(ctx, toSelectRequest(q)); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	qr = fromSelectResponse(resp, q.Limit)
+	return
+}
+
+//Summary returns a summary of all indices.
+func (c *Client) Summary(ctx context.Context) (sum string, err error) {
+	var resp *rpcpb.SummaryResponse
+	if resp, err = c.c.Summary(ctx, &rpcpb.Empty{}); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	sum = resp.Summary
+	return
+}
+
+//Snapshot writes a snapshot archive of all indices to w, which indexer.Indexer.ReadSnapshot accepts.
+func (c *Client) Snapshot(ctx context.Context, w io.Writer) (err error) {
+	var stream rpcpb.Indexer_SnapshotClient
+	if stream, err = c.c.Snapshot(ctx, &rpcpb.Empty{}); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	var chunk *rpcpb.SnapshotChunk
+	for {
+		if chunk, err = stream.Recv(); err == io.EOF {
+			err = nil
+			return
+		} else if err != nil {
+			err = errors.Wrap(err, "")
+			return
+		}
+		if _, err = w.Write(chunk.Data); err != nil {
+			err = errors.Wrap(err, "")
+			return
+		}
+	}
+}
+
+//Transport is a coordinator.Transport which talks to the nodes with Client. A node is the address of a Server.
+type Transport struct {
+	opts    []grpc.DialOption
+	mu      sync.Mutex
+	clients map[string]*Client
+}
+
+//NewTransport creates a Transport. opts are passed to Dial.
+func NewTransport(opts ...grpc.DialOption) *Transport {
+	return &Transport{opts: opts, clients: make(map[string]*Client)}
+}
+
+//Select implements coordinator.Transport. Connections are set up at the first query of every node, and reused.
+func (t *Transport) Select(ctx context.Context, node string, q *cql.CqlSelect) (qr *indexer.QueryResult, err error) {
+	var c *Client
+	t.mu.Lock()
+	if c = t.clients[node]; c == nil {
+		if c, err = Dial(node, t.opts...); err != nil {
+			t.mu.Unlock()
+			return
+		}
+		t.clients[node] = c
+	}
+	t.mu.Unlock()
+	qr, err = c.Select(ctx, q)
+	return
+}
+
+//Close closes the connections to all nodes.
+func (t *Transport) Close() (err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for node, c := range t.clients {
+		if err2 := c.Close(); err2 != nil && err == nil {
+			err = err2
+		}
+		delete(t.clients, node)
+	}
+	return
+}
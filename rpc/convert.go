@@ -0,0 +1,113 @@
+package rpc
+
+import (
+	"sort"
+
+	"github.com/deepfabric/bkdtree"
+	"github.com/deepfabric/indexer"
+	"github.com/deepfabric/indexer/cql"
+	"github.com/deepfabric/indexer/rpc/rpcpb"
+	"github.com/pilosa/pilosa"
+)
+
+//toSelectRequest converts q to its wire form. Predicates are sorted by property name so that the encoding is deterministic.
+func toSelectRequest(q *cql.CqlSelect) (req *rpcpb.SelectRequest) {
+	req = &rpcpb.SelectRequest{
+		Index:     q.Index,
+		OrderBy:   q.OrderBy,
+		Limit:     int32(q.Limit),
+		Highlight: q.Highlight,
+	}
+	for _, pred := range q.UintPreds {
+		req.UintPreds = append(req.UintPreds, rpcpb.UintPred{Name: pred.Name, Low: pred.Low, High: pred.High})
+	}
+	sort.Slice(req.UintPreds, func(i, j int) bool { return req.UintPreds[i].Name < req.UintPreds[j].Name })
+	for _, pred := range q.EnumPreds {
+		inVals := make([]int64, len(pred.InVals))
+		for i, val := range pred.InVals {
+			inVals[i] = int64(val)
+		}
+		req.EnumPreds = append(req.EnumPreds, rpcpb.EnumPred{Name: pred.Name, InVals: inVals})
+	}
+	sort.Slice(req.EnumPreds, func(i, j int) bool { return req.EnumPreds[i].Name < req.EnumPreds[j].Name })
+	for _, pred := range q.StrPreds {
+		req.StrPreds = append(req.StrPreds, rpcpb.StrPred{Name: pred.Name, ContWord: pred.ContWord})
+	}
+	sort.Slice(req.StrPreds, func(i, j int) bool { return req.StrPreds[i].Name < req.StrPreds[j].Name })
+	for _, pred := range q.ExistPreds {
+		req.ExistPreds = append(req.ExistPreds, rpcpb.ExistPred{Name: pred.Name, Exists: pred.Exists})
+	}
+	sort.Slice(req.ExistPreds, func(i, j int) bool { return req.ExistPreds[i].Name < req.ExistPreds[j].Name })
+	return
+}
+
+//fromSelectRequest converts req back to a cql.CqlSelect.
+func fromSelectRequest(req *rpcpb.SelectRequest) (q *cql.CqlSelect) {
+	q = &cql.CqlSelect{
+		Index:      req.Index,
+		UintPreds:  make(map[string]cql.UintPred, len(req.UintPreds)),
+		EnumPreds:  make(map[string]cql.EnumPred, len(req.EnumPreds)),
+		StrPreds:   make(map[string]cql.StrPred, len(req.StrPreds)),
+		ExistPreds: make(map[string]cql.ExistPred, len(req.ExistPreds)),
+		OrderBy:    req.OrderBy,
+		Limit:      int(req.Limit),
+		Highlight:  req.Highlight,
+	}
+	for _, pred := range req.UintPreds {
+		q.UintPreds[pred.Name] = cql.UintPred{Name: pred.Name, Low: pred.Low, High: pred.High}
+	}
+	for _, pred := range req.EnumPreds {
+		inVals := make([]int, len(pred.InVals))
+		for i, val := range pred.InVals {
+			inVals[i] = int(val)
+		}
+		q.EnumPreds[pred.Name] = cql.EnumPred{Name: pred.Name, InVals: inVals}
+	}
+	for _, pred := range req.StrPreds {
+		q.StrPreds[pred.Name] = cql.StrPred{Name: pred.Name, ContWord: pred.ContWord}
+	}
+	for _, pred := range req.ExistPreds {
+		q.ExistPreds[pred.Name] = cql.ExistPred{Name: pred.Name, Exists: pred.Exists}
+	}
+	return
+}
+
+//toSelectResponse converts qr to its wire form. It finalizes qr.Oa, so qr shall not be used afterwards.
+func toSelectResponse(qr *indexer.QueryResult) (resp *rpcpb.SelectResponse) {
+	resp = &rpcpb.SelectResponse{DocIDs: qr.Bm.Bits()}
+	for _, item := range qr.Oa.Finalize() {
+		point := item.(bkdtree.Point)
+		resp.Points = append(resp.Points, rpcpb.Point{Vals: point.Vals, DocID: point.UserData})
+	}
+	for docID, frags := range qr.Hl {
+		for prop, fragments := range frags {
+			resp.Highlights = append(resp.Highlights, rpcpb.Highlight{DocID: docID, Property: prop, Fragments: fragments})
+		}
+	}
+	sort.Slice(resp.Highlights, func(i, j int) bool {
+		hi, hj := resp.Highlights[i], resp.Highlights[j]
+		return hi.DocID < hj.DocID || (hi.DocID == hj.DocID && hi.Property < hj.Property)
+	})
+	return
+}
+
+//fromSelectResponse converts resp back to an indexer.QueryResult. limit shall be the Limit of the query.
+func fromSelectResponse(resp *rpcpb.SelectResponse, limit int) (qr *indexer.QueryResult) {
+	qr = indexer.NewQueryResult(limit)
+	qr.Bm = pilosa.NewBitmap(resp.DocIDs...)
+	for _, point := range resp.Points {
+		qr.Oa.Put(bkdtree.Point{Vals: point.Vals, UserData: point.DocID})
+	}
+	for _, hl := range resp.Highlights {
+		if qr.Hl == nil {
+			qr.Hl = make(map[uint64]map[string][]string)
+		}
+		frags, ok := qr.Hl[hl.DocID]
+		if !ok {
+			frags = make(map[string][]string)
+			qr.Hl[hl.DocID] = frags
+		}
+		frags[hl.Property] = hl.Fragments
+	}
+	return
+}
@@ -0,0 +1,201 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/deepfabric/indexer"
+	"github.com/deepfabric/indexer/coordinator"
+	"github.com/deepfabric/indexer/cql"
+	"github.com/pilosa/pilosa"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	NumDocs = 1000
+)
+
+func newDocProt() *cql.DocumentWithIdx {
+	return &cql.DocumentWithIdx{
+		Doc: cql.Document{
+			DocID: 0,
+			UintProps: []*cql.UintProp{
+				&cql.UintProp{
+					Name:   "price",
+					ValLen: 4,
+					Val:    0,
+				},
+			},
+			StrProps: []*cql.StrProp{
+				&cql.StrProp{
+					Name: "description",
+					Val:  "",
+				},
+			},
+		},
+		Index: "orders",
+	}
+}
+
+func newQuery() *cql.CqlSelect {
+	return &cql.CqlSelect{
+		Index: "orders",
+		UintPreds: map[string]cql.UintPred{
+			"price": cql.UintPred{
+				Name: "price",
+				Low:  50,
+				High: 250,
+			},
+		},
+		StrPreds: map[string]cql.StrPred{
+			"description": cql.StrPred{
+				Name:     "description",
+				ContWord: "doc3",
+			},
+		},
+		Limit: 5,
+	}
+}
+
+//serve serves ir at a random local port.
+func serve(t *testing.T, ir *indexer.Indexer) (addr string, gs *grpc.Server) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	gs = grpc.NewServer()
+	NewServer(ir).Register(gs)
+	go gs.Serve(lis)
+	addr = lis.Addr().String()
+	return
+}
+
+func TestRPC(t *testing.T) {
+	ir, err := indexer.NewIndexer("/tmp/rpc_test/server", true, false)
+	require.NoError(t, err)
+	defer ir.Close()
+	addr, gs := serve(t, ir)
+	defer gs.Stop()
+
+	c, err := Dial(addr)
+	require.NoError(t, err)
+	defer c.Close()
+	ctx := context.Background()
+
+	//TESTCASE: create an index, and create it again with a different schema
+	err = c.CreateIndex(ctx, newDocProt())
+	require.NoError(t, err)
+	docProt := newDocProt()
+	docProt.Doc.UintProps[0].ValLen = 8
+	err = c.CreateIndex(ctx, docProt)
+	require.Equal(t, codes.FailedPrecondition, status.Code(errors.Cause(err)))
+
+	//TESTCASE: streamed docs are inserted in batches, and errors are returned per doc
+	docs := make([]*cql.DocumentWithIdx, 0, NumDocs+1)
+	for i := 0; i < NumDocs; i++ {
+		doc := newDocProt()
+		doc.Doc.DocID = uint64(i)
+		doc.Doc.UintProps[0].Val = uint64(i)
+		doc.Doc.StrProps[0].Val = fmt.Sprintf("doc%d", i%10)
+		docs = append(docs, doc)
+	}
+	doc := newDocProt()
+	doc.Index = "users"
+	docs = append(docs, doc)
+	errs, err := c.InsertBatch(ctx, docs)
+	require.NoError(t, err)
+	require.Equal(t, len(docs), len(errs))
+	for i := 0; i < NumDocs; i++ {
+		require.NoError(t, errs[i])
+	}
+	require.Error(t, errs[NumDocs])
+
+	//TESTCASE: results over gRPC are the same as the local ones
+	q := newQuery()
+	qr, err := c.Select(ctx, q)
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), qr.Bm.Count())
+
+	q.OrderBy = "price"
+	qr, err = c.Select(ctx, q)
+	require.NoError(t, err)
+	local, err := ir.Select(q)
+	require.NoError(t, err)
+	require.Equal(t, local.Oa.Finalize(), qr.Oa.Finalize())
+
+	//TESTCASE: an unknown index is NotFound
+	q.Index = "users"
+	_, err = c.Select(ctx, q)
+	require.Equal(t, codes.NotFound, status.Code(errors.Cause(err)))
+
+	//TESTCASE: delete a doc
+	found, err := c.Del(ctx, "orders", 3)
+	require.NoError(t, err)
+	require.True(t, found)
+	found, err = c.Del(ctx, "orders", 3)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	sum, err := c.Summary(ctx)
+	require.NoError(t, err)
+	require.Contains(t, sum, "orders")
+
+	//TESTCASE: a streamed snapshot restores to another indexer
+	var buf bytes.Buffer
+	err = c.Snapshot(ctx, &buf)
+	require.NoError(t, err)
+	ir2, err := indexer.NewIndexer("/tmp/rpc_test/restored", true, false)
+	require.NoError(t, err)
+	defer ir2.Close()
+	err = ir2.ReadSnapshot(&buf)
+	require.NoError(t, err)
+	q = newQuery()
+	qr, err = ir2.Select(q)
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), qr.Bm.Count())
+
+	//TESTCASE: destroy the index
+	err = c.DestroyIndex(ctx, "orders")
+	require.NoError(t, err)
+	_, err = c.Del(ctx, "orders", 4)
+	require.Equal(t, codes.NotFound, status.Code(errors.Cause(err)))
+}
+
+func TestTransport(t *testing.T) {
+	var nodes []string
+	for n := 0; n < 2; n++ {
+		ir, err := indexer.NewIndexer(fmt.Sprintf("/tmp/rpc_test/node%d", n), true, false)
+		require.NoError(t, err)
+		defer ir.Close()
+		err = ir.CreateIndex(newDocProt())
+		require.NoError(t, err)
+		for i := 0; i < NumDocs/2; i++ {
+			doc := newDocProt()
+			doc.Doc.DocID = uint64(n)*pilosa.SliceWidth + uint64(i)
+			doc.Doc.UintProps[0].Val = uint64(n*NumDocs/2 + i)
+			doc.Doc.StrProps[0].Val = fmt.Sprintf("doc%d", i%10)
+			err = ir.Insert(doc)
+			require.NoError(t, err)
+		}
+		addr, gs := serve(t, ir)
+		defer gs.Stop()
+		nodes = append(nodes, addr)
+	}
+
+	//TESTCASE: the coordinator fans out over gRPC
+	trans := NewTransport()
+	defer trans.Close()
+	c, err := coordinator.NewCoordinator(nodes, trans, coordinator.Options{})
+	require.NoError(t, err)
+	q := newQuery()
+	q.UintPreds["price"] = cql.UintPred{Name: "price", Low: 450, High: 550}
+	res, err := c.Select(context.Background(), q)
+	require.NoError(t, err)
+	require.Nil(t, res.Failed)
+	require.Equal(t, uint64(10), res.Bm.Count())
+}
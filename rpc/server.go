@@ -0,0 +1,175 @@
+package rpc
+
+import (
+	"context"
+	"io"
+
+	"github.com/deepfabric/indexer"
+	"github.com/deepfabric/indexer/cql"
+	"github.com/deepfabric/indexer/rpc/rpcpb"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	//InsertBatchSize is the number of streamed documents Server inserts with one indexer.Indexer.InsertBatch call.
+	InsertBatchSize = 1000
+	//SnapshotChunkSize is the max size of a chunk of the snapshot archive streamed by Server.
+	SnapshotChunkSize = 64 * 1024
+)
+
+//Server serves an indexer.Indexer over gRPC.
+type Server struct {
+	ir *indexer.Indexer
+}
+
+//NewServer creates a Server of ir.
+func NewServer(ir *indexer.Indexer) *Server {
+	return &Server{ir: ir}
+}
+
+//Register registers s to gs.
+func (s *Server) Register(gs *grpc.Server) {
+	rpcpb.RegisterIndexerServer(gs, s)
+}
+
+//CreateIndex implements rpcpb.IndexerServer.
+func (s *Server) CreateIndex(ctx context.Context, docProt *cql.DocumentWithIdx) (*rpcpb.Empty, error) {
+	if err := s.ir.CreateIndex(docProt); err != nil {
+		return nil, toStatus(err)
+	}
+	return &rpcpb.Empty{}, nil
+}
+
+//DestroyIndex implements rpcpb.IndexerServer.
+func (s *Server) DestroyIndex(ctx context.Context, req *rpcpb.DestroyIndexRequest) (*rpcpb.Empty, error) {
+	if err := s.ir.DestroyIndex(req.Index); err != nil {
+		return nil, toStatus(err)
+	}
+	return &rpcpb.Empty{}, nil
+}
+
+//Insert implements rpcpb.IndexerServer.
+func (s *Server) Insert(ctx context.Context, doc *cql.DocumentWithIdx) (*rpcpb.Empty, error) {
+	if err := s.ir.Insert(doc); err != nil {
+		return nil, toStatus(err)
+	}
+	return &rpcpb.Empty{}, nil
+}
+
+//InsertBatch implements rpcpb.IndexerServer.
+func (s *Server) InsertBatch(stream rpcpb.Indexer_InsertBatchServer) error {
+	resp := &rpcpb.InsertBatchResponse{}
+	docs := make([]*cql.DocumentWithIdx, 0, InsertBatchSize)
+	flush := func() error {
+		if len(docs) == 0 {
+			return nil
+		}
+		errs, err := s.ir.InsertBatch(docs)
+		if err != nil {
+			return toStatus(err)
+		}
+		for _, e := range errs {
+			var msg string
+			if e != nil {
+				msg = e.Error()
+			}
+			resp.Errors = append(resp.Errors, msg)
+		}
+		docs = docs[:0]
+		return nil
+	}
+	for {
+		doc, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if docs = append(docs, doc); len(docs) == InsertBatchSize {
+			if err = flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	return stream.SendAndClose(resp)
+}
+
+//Del implements rpcpb.IndexerServer.
+func (s *Server) Del(ctx context.Context, req *cql.DocumentDel) (*rpcpb.DelResponse, error) {
+	found, err := s.ir.Del(req.Index, req.DocID)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &rpcpb.DelResponse{Found: found}, nil
+}
+
+//Select implements rpcpb.IndexerServer.
+func (s *Server) Select(ctx context.Context, req *rpcpb.SelectRequest) (*rpcpb.SelectResponse, error) {
+	qr, err := s.ir.Select(fromSelectRequest(req))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toSelectResponse(qr), nil
+}
+
+//Summary implements rpcpb.IndexerServer.
+func (s *Server) Summary(ctx context.Context, req *rpcpb.Empty) (*rpcpb.SummaryResponse, error) {
+	sum, err := s.ir.Summary()
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &rpcpb.SummaryResponse{Summary: sum}, nil
+}
+
+//Snapshot implements rpcpb.IndexerServer.
+func (s *Server) Snapshot(req *rpcpb.Empty, stream rpcpb.Indexer_SnapshotServer) error {
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(s.ir.WriteSnapshot(pw))
+	}()
+	//unblock the writer if the stream breaks
+	defer pr.Close()
+	buf := make([]byte, SnapshotChunkSize)
+	for {
+		n, err := io.ReadFull(pr, buf)
+		if n > 0 {
+			if err := stream.Send(&rpcpb.SnapshotChunk{Data: buf[:n]}); err != nil {
+				return err
+			}
+		}
+		switch err {
+		case nil:
+		case io.EOF, io.ErrUnexpectedEOF:
+			return nil
+		default:
+			return toStatus(err)
+		}
+	}
+}
+
+//toStatus converts an error of indexer.Indexer to a gRPC status error.
+func toStatus(err error) error {
+	code := codes.Internal
+	switch errors.Cause(err) {
+	case indexer.ErrIdxNotExist, indexer.ErrNoDocStore:
+		code = codes.NotFound
+	case indexer.ErrIdxExist, indexer.ErrDocExist, indexer.ErrPropExist:
+		code = codes.AlreadyExists
+	case indexer.ErrSchemaMismatch:
+		code = codes.FailedPrecondition
+	case indexer.ErrUnknownProp:
+		code = codes.InvalidArgument
+	}
+	if code == codes.Internal {
+		log.Errorf("rpc server internal error: %+v", err)
+	}
+	return status.Error(code, err.Error())
+}
@@ -0,0 +1,845 @@
+package indexer
+
+import (
+	"archive/tar"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"syscall"
+
+	"github.com/deepfabric/bkdtree"
+	"github.com/deepfabric/indexer/cql"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// SnapshotManifestFile describes the content of a snapshot directory.
+	SnapshotManifestFile = "snapshot_manifest.json"
+	// suffixes of the directories beside MainDir which ApplySnapshot swaps with MainDir
+	stagedDirSuffix   = ".staged"
+	previousDirSuffix = ".previous"
+	// suffix of the directory beside MainDir where RestoreIndex stages an index and keeps the replaced one
+	restoringDirSuffix = ".restoring"
+)
+
+var (
+	ErrIncompleteSnapshot = errors.New("incomplete snapshot")
+	ErrChecksumMismatch   = errors.New("checksum mismatch")
+	ErrBadSnapshotArchive = errors.New("bad snapshot archive")
+	crcTable              = crc32.MakeTable(crc32.Castagnoli)
+)
+
+//SnapshotManifest describes a snapshot created by CreateSnapshotExt.
+type SnapshotManifest struct {
+	//AppliedIndex is the applied index of the Indexer when the snapshot was created.
+	AppliedIndex uint64
+	//Fragments maps the path of every fragment file, relative to the index directory of the snapshot, to its CRC32 checksum.
+	Fragments map[string]uint32
+	//Files maps the path of every other file, relative to the index directory of the snapshot, to its CRC32 checksum.
+	Files map[string]uint32
+	//Omitted lists the fragments of an incremental snapshot which are unchanged since the base snapshot. Their files are absent.
+	Omitted []string
+}
+
+//SnapshotOptions controls the behavior of CreateSnapshotExt.
+type SnapshotOptions struct {
+	//Base is the manifest of a previous snapshot. If it's given, the snapshot is incremental and omits
+	//the fragments whose checksums are unchanged since Base.
+	Base *SnapshotManifest
+}
+
+//CreateSnapshotExt creates a snapshot of all indices in snapDir, which shall be on the same file system as MainDir.
+//Fragment files are hard-linked instead of copied, and only the other files, such as term dictionaries and meta, are copied.
+//The write lock is held only while linking and copying. The checksums are calculated afterwards.
+func (ir *Indexer) CreateSnapshotExt(snapDir string, opts SnapshotOptions) (manifest *SnapshotManifest, numList []uint64, err error) {
+	dst := filepath.Join(snapDir, "index")
+	if err = clearSnapshotDir(snapDir); err != nil {
+		return
+	}
+	var appliedIndex uint64
+	if appliedIndex, numList, err = ir.linkSnapshot(dst); err != nil {
+		return
+	}
+	//the linked fragments never change, so it's safe to read them without holding the lock
+	if manifest, err = writeSnapshotManifest(snapDir, appliedIndex, opts.Base); err != nil {
+		return
+	}
+	log.Infof("created snapshot %v, applied index %v, %v fragments, %v omitted", snapDir, manifest.AppliedIndex, len(manifest.Fragments), len(manifest.Omitted))
+	return
+}
+
+//SnapshotIndex creates a snapshot of the given index in snapDir. It has the layout of a snapshot created by
+//CreateSnapshotExt, but contains only the conf file and the directory of the index, whose fragments are hard-linked.
+func (ir *Indexer) SnapshotIndex(name, snapDir string) (manifest *SnapshotManifest, err error) {
+	dst := filepath.Join(snapDir, "index")
+	if err = clearSnapshotDir(snapDir); err != nil {
+		return
+	}
+	var appliedIndex uint64
+	if appliedIndex, err = ir.linkIndexSnapshot(name, dst); err != nil {
+		return
+	}
+	if manifest, err = writeSnapshotManifest(snapDir, appliedIndex, nil); err != nil {
+		return
+	}
+	log.Infof("created snapshot %v of index %v, applied index %v, %v fragments", snapDir, name, manifest.AppliedIndex, len(manifest.Fragments))
+	return
+}
+
+//clearSnapshotDir removes the content of a previous snapshot in snapDir.
+func clearSnapshotDir(snapDir string) (err error) {
+	if err = os.RemoveAll(filepath.Join(snapDir, "index")); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	if err = os.Remove(filepath.Join(snapDir, SnapshotManifestFile)); err != nil {
+		if os.IsNotExist(err) {
+			err = nil
+		} else {
+			err = errors.Wrap(err, "")
+		}
+	}
+	return
+}
+
+//writeSnapshotManifest calculates the checksums of the files in snapDir, and writes the manifest.
+//If base is given, the fragments whose checksums are unchanged since it are removed.
+func writeSnapshotManifest(snapDir string, appliedIndex uint64, base *SnapshotManifest) (manifest *SnapshotManifest, err error) {
+	dst := filepath.Join(snapDir, "index")
+	manifest = &SnapshotManifest{AppliedIndex: appliedIndex, Fragments: make(map[string]uint32), Files: make(map[string]uint32)}
+	var files []string
+	if files, err = listFiles(dst); err != nil {
+		return
+	}
+	var sum uint32
+	for _, rel := range files {
+		fp := filepath.Join(dst, rel)
+		if sum, err = fileChecksum(fp); err != nil {
+			return
+		}
+		if !isFragment(rel) {
+			manifest.Files[rel] = sum
+			continue
+		}
+		manifest.Fragments[rel] = sum
+		if base != nil {
+			if baseSum, found := base.Fragments[rel]; found && baseSum == sum {
+				if err = os.Remove(fp); err != nil {
+					err = errors.Wrap(err, "")
+					return
+				}
+				manifest.Omitted = append(manifest.Omitted, rel)
+			}
+		}
+	}
+	err = bkdtree.FileMarshal(filepath.Join(snapDir, SnapshotManifestFile), manifest)
+	return
+}
+
+//linkSnapshot syncs the indices, hard-links their fragments to dst, and copies the other files except WAL.
+func (ir *Indexer) linkSnapshot(dst string) (appliedIndex uint64, numList []uint64, err error) {
+	ir.rwlock.Lock()
+	defer ir.rwlock.Unlock()
+	if err = ir.sync(); err != nil {
+		return
+	}
+	for _, ind := range ir.indices {
+		if err = ind.linkFragments(dst); err != nil {
+			return
+		}
+	}
+	if err = copyNonFragments(ir.MainDir, dst, "wal"); err != nil {
+		return
+	}
+	appliedIndex = atomic.LoadUint64(&ir.entIndex)
+	numList = ir.getDocIDFragList()
+	return
+}
+
+//linkIndexSnapshot syncs the given index, hard-links its fragments to dst, and copies its other files and conf file.
+func (ir *Indexer) linkIndexSnapshot(name, dst string) (appliedIndex uint64, err error) {
+	ir.rwlock.Lock()
+	defer ir.rwlock.Unlock()
+	ind, found := ir.indices[name]
+	if !found {
+		err = errors.Wrapf(ErrIdxNotExist, "index %v doesn't exist", name)
+		return
+	}
+	if err = ind.Sync(); err != nil {
+		return
+	}
+	if err = ind.linkFragments(dst); err != nil {
+		return
+	}
+	if err = copyNonFragments(filepath.Join(ir.MainDir, name), filepath.Join(dst, name)); err != nil {
+		return
+	}
+	conf := fmt.Sprintf("index_%s.json", name)
+	if err = CopyFile(filepath.Join(ir.MainDir, conf), filepath.Join(dst, conf)); err != nil {
+		return
+	}
+	appliedIndex = atomic.LoadUint64(&ir.entIndex)
+	return
+}
+
+//RestoreIndex restores the index name from the snapshot in snapDir, which is created by SnapshotIndex or CreateSnapshot,
+//as the index newName. An existing index newName is replaced, and the other indices are not touched.
+//The restoration isn't written to WAL. All indices are persisted and WAL is compacted before it, so that no earlier
+//entry is replayed onto the restored index. The index is staged beside MainDir, and NewIndexer finishes or rolls back
+//a restoration interrupted by a crash.
+func (ir *Indexer) RestoreIndex(name, snapDir, newName string) (err error) {
+	var manifest *SnapshotManifest
+	if _, err = os.Stat(filepath.Join(snapDir, SnapshotManifestFile)); err == nil {
+		if manifest, err = ReadSnapshotManifest(snapDir); err != nil {
+			return
+		}
+	} else if !os.IsNotExist(err) {
+		err = errors.Wrap(err, "")
+		return
+	}
+	src := filepath.Join(snapDir, "index")
+	docProt := &cql.DocumentWithIdx{}
+	if err = indexReadConf(src, name, docProt); err != nil {
+		return
+	}
+	docProt.Index = newName
+	//stage beside MainDir, so that neither a snapshot nor a crash leaves a partial copy inside it
+	restoreDir := filepath.Clean(ir.MainDir) + restoringDirSuffix
+	stage := filepath.Join(restoreDir, newName)
+	stagedConf := filepath.Join(restoreDir, fmt.Sprintf("index_%s.json", newName))
+	discard := func() {
+		os.RemoveAll(stage)
+		os.Remove(stagedConf)
+	}
+	if err = stageIndex(src, name, stage, manifest); err != nil {
+		discard()
+		return
+	}
+
+	ir.rwlock.Lock()
+	defer ir.rwlock.Unlock()
+	if err = ir.sync(); err != nil {
+		discard()
+		return
+	}
+	dir := filepath.Join(ir.MainDir, newName)
+	previous := filepath.Join(restoreDir, newName+previousDirSuffix)
+	oldDocProt, exists := ir.docProts[newName]
+	if exists {
+		docProt.Version = oldDocProt.Version + 1
+	}
+	//recoverRestore finishes the restoration with the staged conf once the staged directory is moved into MainDir
+	if err = indexWriteConf(restoreDir, docProt); err != nil {
+		discard()
+		return
+	}
+	if exists {
+		if err = ir.indices[newName].Close(); err != nil {
+			discard()
+			ir.reopenIndex(oldDocProt)
+			return
+		}
+		err = swapDir(dir, stage, previous)
+	} else {
+		err = os.Rename(stage, dir)
+	}
+	if err != nil {
+		discard()
+		ir.reopenIndex(oldDocProt)
+		return
+	}
+	var ind *Index
+	if err = indexWriteConf(ir.MainDir, docProt); err == nil {
+		ind, err = NewIndexExt(ir.MainDir, newName)
+	}
+	if err != nil {
+		log.Errorf("indexer %v failed to open restored index %v, rolling back: %+v", ir.MainDir, newName, err)
+		if ind != nil {
+			ind.Close()
+		}
+		if exists {
+			//write the previous conf first, so that a crash during the swap rolls back to a consistent index
+			if err2 := indexWriteConf(ir.MainDir, oldDocProt); err2 != nil {
+				log.Errorf("indexer %v failed to roll back index %v, the previous data is kept at %v: %+v", ir.MainDir, newName, previous, err2)
+				return
+			}
+			if err2 := swapDir(dir, previous, stage); err2 != nil {
+				log.Errorf("indexer %v failed to roll back index %v, the previous data is kept at %v: %+v", ir.MainDir, newName, previous, err2)
+				return
+			}
+		} else if err2 := ir.removeIndex(newName); err2 != nil {
+			log.Errorf("indexer %v failed to remove index %v: %+v", ir.MainDir, newName, err2)
+		}
+		discard()
+		ir.reopenIndex(oldDocProt)
+		return
+	}
+	ind.Parallelism = ir.queryParallelism
+	ir.indices[newName] = ind
+	ir.docProts[newName] = docProt
+	if err = os.RemoveAll(previous); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	if err = os.Remove(stagedConf); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	//fails if another restoration is in progress
+	os.Remove(restoreDir)
+	log.Infof("indexer %v restored index %v from %v as %v", ir.MainDir, name, snapDir, newName)
+	return
+}
+
+//reopenIndex reopens an index after a failed restoration. docProt is nil if there was no such index.
+func (ir *Indexer) reopenIndex(docProt *cql.DocumentWithIdx) {
+	if docProt == nil {
+		return
+	}
+	if err := indexWriteConf(ir.MainDir, docProt); err != nil {
+		log.Errorf("indexer %v failed to reopen index %v: %+v", ir.MainDir, docProt.Index, err)
+		return
+	}
+	ind, err := NewIndexExt(ir.MainDir, docProt.Index)
+	if err != nil {
+		log.Errorf("indexer %v failed to reopen index %v: %+v", ir.MainDir, docProt.Index, err)
+		delete(ir.indices, docProt.Index)
+		delete(ir.docProts, docProt.Index)
+		return
+	}
+	ind.Parallelism = ir.queryParallelism
+	ir.indices[docProt.Index] = ind
+}
+
+//stageIndex copies the directory of index name under src to stage, and verifies it and the conf file against manifest if there's one.
+func stageIndex(src, name, stage string, manifest *SnapshotManifest) (err error) {
+	if err = os.RemoveAll(stage); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	if err = CopyDir(filepath.Join(src, name), stage); err != nil {
+		return
+	}
+	if manifest == nil {
+		return
+	}
+	prefix := name + string(filepath.Separator)
+	for _, rel := range manifest.Omitted {
+		if strings.HasPrefix(rel, prefix) {
+			err = errors.Wrapf(ErrIncompleteSnapshot, "fragment %v is omitted", rel)
+			return
+		}
+	}
+	if err = verifySnapshot(stage, manifest, prefix); err != nil {
+		return
+	}
+	conf := fmt.Sprintf("index_%s.json", name)
+	var sum uint32
+	if sum, err = fileChecksum(filepath.Join(src, conf)); err != nil {
+		return
+	}
+	if sum != manifest.Files[conf] {
+		err = errors.Wrapf(ErrChecksumMismatch, "file %v", conf)
+	}
+	return
+}
+
+//ReadSnapshotManifest reads the manifest of the snapshot in snapDir.
+func ReadSnapshotManifest(snapDir string) (manifest *SnapshotManifest, err error) {
+	manifest = &SnapshotManifest{}
+	err = bkdtree.FileUnmarshal(filepath.Join(snapDir, SnapshotManifestFile), manifest)
+	return
+}
+
+//CompleteSnapshot fills the fragments omitted by the incremental snapshot in snapDir from its base snapshot in baseDir,
+//so that snapDir can be applied. The checksums of the filled fragments are verified.
+func CompleteSnapshot(snapDir, baseDir string) (err error) {
+	var manifest *SnapshotManifest
+	if manifest, err = ReadSnapshotManifest(snapDir); err != nil {
+		return
+	}
+	var sum uint32
+	for _, rel := range manifest.Omitted {
+		src := filepath.Join(baseDir, "index", rel)
+		dst := filepath.Join(snapDir, "index", rel)
+		if sum, err = fileChecksum(src); err != nil {
+			return
+		}
+		if sum != manifest.Fragments[rel] {
+			err = errors.Wrapf(ErrChecksumMismatch, "fragment %v of base snapshot %v", rel, baseDir)
+			return
+		}
+		if err = os.MkdirAll(filepath.Dir(dst), 0700); err != nil {
+			err = errors.Wrap(err, "")
+			return
+		}
+		if _, err = linkOrCopy(src, dst); err != nil {
+			return
+		}
+	}
+	manifest.Omitted = nil
+	err = bkdtree.FileMarshal(filepath.Join(snapDir, SnapshotManifestFile), manifest)
+	return
+}
+
+//stageSnapshot copies the index directory src of a snapshot to stage, verifies it against manifest if there's one,
+//and checks that it opens.
+func stageSnapshot(src, stage string, manifest *SnapshotManifest) (err error) {
+	if err = os.RemoveAll(stage); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	if _, err = os.Stat(src); os.IsNotExist(err) {
+		log.Infof("snapshot source directory %v doesn't exist, treating it as an empty one", src)
+		if err = os.MkdirAll(stage, 0700); err != nil {
+			err = errors.Wrap(err, "")
+		}
+		return
+	}
+	if err = CopyDir(src, stage); err != nil {
+		return
+	}
+	if manifest != nil {
+		if err = verifySnapshot(stage, manifest, ""); err != nil {
+			return
+		}
+	}
+	probe := &Indexer{MainDir: stage, MaxOpN: DefaultIndexerMaxOpN}
+	if err = probe.open(); err != nil {
+		probe.discard()
+		return
+	}
+	err = probe.close()
+	return
+}
+
+//verifySnapshot checks the files under dir against the checksums of manifest.
+//Only the files whose paths start with prefix are checked, and the prefix is stripped to get their paths under dir.
+func verifySnapshot(dir string, manifest *SnapshotManifest, prefix string) (err error) {
+	var sum uint32
+	for _, sums := range []map[string]uint32{manifest.Fragments, manifest.Files} {
+		for rel, want := range sums {
+			if !strings.HasPrefix(rel, prefix) {
+				continue
+			}
+			if sum, err = fileChecksum(filepath.Join(dir, strings.TrimPrefix(rel, prefix))); err != nil {
+				return
+			}
+			if sum != want {
+				err = errors.Wrapf(ErrChecksumMismatch, "file %v", rel)
+				return
+			}
+		}
+	}
+	return
+}
+
+//swapDir replaces dir with newDir, and moves dir to oldDir. dir is restored if newDir can't be moved.
+func swapDir(dir, newDir, oldDir string) (err error) {
+	if err = os.RemoveAll(oldDir); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	if err = os.Rename(dir, oldDir); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	if err = os.Rename(newDir, dir); err != nil {
+		err = errors.Wrap(err, "")
+		if err2 := os.Rename(oldDir, dir); err2 != nil {
+			log.Errorf("failed to restore %v from %v: %+v", dir, oldDir, err2)
+		}
+		return
+	}
+	err = syncDir(filepath.Dir(dir))
+	return
+}
+
+//recoverApply finishes or rolls back an ApplySnapshot of mainDir which is interrupted by a crash.
+func recoverApply(mainDir string) (err error) {
+	mainDir = filepath.Clean(mainDir)
+	previous := mainDir + previousDirSuffix
+	if err = os.RemoveAll(mainDir + stagedDirSuffix); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	if _, err = os.Stat(previous); err != nil {
+		if os.IsNotExist(err) {
+			err = nil
+		} else {
+			err = errors.Wrap(err, "")
+		}
+		return
+	}
+	if _, err = os.Stat(mainDir); err == nil {
+		//the snapshot had been verified and swapped in
+		log.Warnf("indexer %v finishes an interrupted snapshot apply", mainDir)
+		if err = os.RemoveAll(previous); err != nil {
+			err = errors.Wrap(err, "")
+		}
+		return
+	} else if !os.IsNotExist(err) {
+		err = errors.Wrap(err, "")
+		return
+	}
+	log.Warnf("indexer %v rolls back an interrupted snapshot apply", mainDir)
+	if err = os.Rename(previous, mainDir); err != nil {
+		err = errors.Wrap(err, "")
+	}
+	return
+}
+
+//recoverRestore finishes or rolls back the RestoreIndex of every index of mainDir which is interrupted by a crash,
+//and removes the directory where they are staged. A restored index is finished if its staged directory had been
+//moved into mainDir, otherwise the previous one is moved back.
+func recoverRestore(mainDir string) (err error) {
+	restoreDir := filepath.Clean(mainDir) + restoringDirSuffix
+	var entries []os.FileInfo
+	if entries, err = ioutil.ReadDir(restoreDir); err != nil {
+		if os.IsNotExist(err) {
+			err = nil
+		} else {
+			err = errors.Wrap(err, "")
+		}
+		return
+	}
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.Name(), "index_") || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+		name := strings.TrimSuffix(strings.TrimPrefix(entry.Name(), "index_"), ".json")
+		if fileExists(filepath.Join(restoreDir, name)) || !fileExists(filepath.Join(mainDir, name)) {
+			continue
+		}
+		log.Warnf("indexer %v finishes an interrupted restoration of index %v", mainDir, name)
+		if err = CopyFile(filepath.Join(restoreDir, entry.Name()), filepath.Join(mainDir, entry.Name())); err != nil {
+			return
+		}
+	}
+	for _, entry := range entries {
+		if !strings.HasSuffix(entry.Name(), previousDirSuffix) {
+			continue
+		}
+		dir := filepath.Join(mainDir, strings.TrimSuffix(entry.Name(), previousDirSuffix))
+		if fileExists(dir) {
+			continue
+		}
+		log.Warnf("indexer %v rolls back an interrupted restoration of index %v", mainDir, filepath.Base(dir))
+		if err = os.Rename(filepath.Join(restoreDir, entry.Name()), dir); err != nil {
+			err = errors.Wrap(err, "")
+			return
+		}
+	}
+	if err = os.RemoveAll(restoreDir); err != nil {
+		err = errors.Wrap(err, "")
+	}
+	return
+}
+
+//fileExists tells whether the file exists.
+func fileExists(fp string) bool {
+	_, err := os.Stat(fp)
+	return err == nil
+}
+
+//syncDir flushes the entries of the directory to disk.
+func syncDir(dir string) (err error) {
+	var f *os.File
+	if f, err = os.Open(dir); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	defer f.Close()
+	if err = f.Sync(); err != nil {
+		err = errors.Wrap(err, "")
+	}
+	return
+}
+
+//WriteSnapshot writes a full snapshot of all indices to w as a tar archive, which ReadSnapshot accepts.
+//The first entry is SnapshotManifestFile, which carries the applied index and the checksums of the other entries.
+//The snapshot is staged in a temporary directory beside MainDir.
+func (ir *Indexer) WriteSnapshot(w io.Writer) (err error) {
+	var snapDir string
+	if snapDir, err = ir.tempSnapshotDir(); err != nil {
+		return
+	}
+	defer os.RemoveAll(snapDir)
+	var manifest *SnapshotManifest
+	if manifest, _, err = ir.CreateSnapshotExt(snapDir, SnapshotOptions{}); err != nil {
+		return
+	}
+	var files []string
+	for rel := range manifest.Files {
+		files = append(files, rel)
+	}
+	for rel := range manifest.Fragments {
+		files = append(files, rel)
+	}
+	sort.Strings(files)
+
+	tw := tar.NewWriter(w)
+	if err = writeArchiveFile(tw, filepath.Join(snapDir, SnapshotManifestFile), SnapshotManifestFile); err != nil {
+		return
+	}
+	for _, rel := range files {
+		if err = writeArchiveFile(tw, filepath.Join(snapDir, "index", rel), path.Join("index", filepath.ToSlash(rel))); err != nil {
+			return
+		}
+	}
+	if err = tw.Close(); err != nil {
+		err = errors.Wrap(err, "")
+	}
+	return
+}
+
+//ReadSnapshot reads a snapshot archive written by WriteSnapshot, and replaces all indices with it.
+//The archive is extracted to a temporary directory beside MainDir and verified against the manifest before
+//any index is touched, so a truncated or corrupted archive leaves the indices intact.
+func (ir *Indexer) ReadSnapshot(r io.Reader) (err error) {
+	var snapDir string
+	if snapDir, err = ir.tempSnapshotDir(); err != nil {
+		return
+	}
+	defer os.RemoveAll(snapDir)
+	if err = extractSnapshot(r, snapDir); err != nil {
+		return
+	}
+	err = ir.ApplySnapshot(snapDir)
+	return
+}
+
+//tempSnapshotDir creates a temporary directory on the same file system as MainDir.
+func (ir *Indexer) tempSnapshotDir() (snapDir string, err error) {
+	mainDir := filepath.Clean(ir.MainDir)
+	if snapDir, err = ioutil.TempDir(filepath.Dir(mainDir), filepath.Base(mainDir)+"_snap"); err != nil {
+		err = errors.Wrap(err, "")
+	}
+	return
+}
+
+//writeArchiveFile writes the file fp to tw as an entry with the given name.
+func writeArchiveFile(tw *tar.Writer, fp, name string) (err error) {
+	var f *os.File
+	if f, err = os.Open(fp); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	defer f.Close()
+	var fi os.FileInfo
+	if fi, err = f.Stat(); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	hdr := &tar.Header{
+		Name:     name,
+		Mode:     0600,
+		Size:     fi.Size(),
+		ModTime:  fi.ModTime(),
+		Typeflag: tar.TypeReg,
+	}
+	if err = tw.WriteHeader(hdr); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	if _, err = io.Copy(tw, f); err != nil {
+		err = errors.Wrap(err, "")
+	}
+	return
+}
+
+//extractSnapshot extracts a snapshot archive to snapDir, and verifies every file against the manifest.
+func extractSnapshot(r io.Reader, snapDir string) (err error) {
+	tr := tar.NewReader(r)
+	var hdr *tar.Header
+	if hdr, err = tr.Next(); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	if hdr.Name != SnapshotManifestFile {
+		err = errors.Wrapf(ErrBadSnapshotArchive, "the first entry is %v instead of the manifest", hdr.Name)
+		return
+	}
+	if _, err = extractFile(tr, filepath.Join(snapDir, SnapshotManifestFile)); err != nil {
+		return
+	}
+	var manifest *SnapshotManifest
+	if manifest, err = ReadSnapshotManifest(snapDir); err != nil {
+		return
+	}
+	if len(manifest.Omitted) != 0 {
+		err = errors.Wrapf(ErrIncompleteSnapshot, "%v fragments are omitted", len(manifest.Omitted))
+		return
+	}
+	remaining := make(map[string]uint32, len(manifest.Fragments)+len(manifest.Files))
+	for rel, sum := range manifest.Fragments {
+		remaining[rel] = sum
+	}
+	for rel, sum := range manifest.Files {
+		remaining[rel] = sum
+	}
+	var sum uint32
+	for {
+		if hdr, err = tr.Next(); err == io.EOF {
+			err = nil
+			break
+		} else if err != nil {
+			err = errors.Wrap(err, "")
+			return
+		}
+		rel := filepath.FromSlash(strings.TrimPrefix(hdr.Name, "index/"))
+		want, found := remaining[rel]
+		if !found || !strings.HasPrefix(hdr.Name, "index/") || !isLocalPath(rel) {
+			err = errors.Wrapf(ErrBadSnapshotArchive, "unexpected entry %v", hdr.Name)
+			return
+		}
+		if sum, err = extractFile(tr, filepath.Join(snapDir, "index", rel)); err != nil {
+			return
+		}
+		if sum != want {
+			err = errors.Wrapf(ErrChecksumMismatch, "entry %v", hdr.Name)
+			return
+		}
+		delete(remaining, rel)
+	}
+	if len(remaining) != 0 {
+		err = errors.Wrapf(ErrBadSnapshotArchive, "%v files are missing", len(remaining))
+	}
+	return
+}
+
+//extractFile writes the content of r to the file fp, and returns the CRC32 checksum of it.
+func extractFile(r io.Reader, fp string) (sum uint32, err error) {
+	if err = os.MkdirAll(filepath.Dir(fp), 0700); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	var f *os.File
+	if f, err = os.OpenFile(fp, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	defer f.Close()
+	h := crc32.New(crcTable)
+	if _, err = io.Copy(io.MultiWriter(f, h), r); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	if err = f.Sync(); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	sum = h.Sum32()
+	return
+}
+
+//isLocalPath tells whether the relative path rel stays inside the directory it's relative to.
+func isLocalPath(rel string) bool {
+	rel = filepath.Clean(rel)
+	return !filepath.IsAbs(rel) && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+//linkOrCopy hard-links src to dst, or copies src to dst if they are on different file systems.
+func linkOrCopy(src, dst string) (linked bool, err error) {
+	if err = os.Link(src, dst); err == nil {
+		linked = true
+		return
+	}
+	if err = CopyFile(src, dst); err != nil {
+		return
+	}
+	return
+}
+
+//isLinked tells whether the file has more than one hard link.
+func isLinked(fp string) bool {
+	fi, err := os.Stat(fp)
+	if err != nil {
+		return false
+	}
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	return ok && st.Nlink > 1
+}
+
+//copyNonFragments copies the files under src to dst, except fragments and the given top-level directories.
+func copyNonFragments(src, dst string, excludes ...string) (err error) {
+	err = filepath.Walk(src, func(fp string, info os.FileInfo, err error) error {
+		if err != nil {
+			return errors.Wrap(err, "")
+		}
+		rel, err := filepath.Rel(src, fp)
+		if err != nil {
+			return errors.Wrap(err, "")
+		}
+		if info.IsDir() {
+			if info.Name() == "fragments" {
+				return filepath.SkipDir
+			}
+			for _, exclude := range excludes {
+				if rel == exclude {
+					return filepath.SkipDir
+				}
+			}
+			return errors.Wrap(os.MkdirAll(filepath.Join(dst, rel), info.Mode()), "")
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return nil
+		}
+		return CopyFile(fp, filepath.Join(dst, rel))
+	})
+	return
+}
+
+//listFiles returns the paths, relative to dir, of all regular files under dir.
+func listFiles(dir string) (files []string, err error) {
+	err = filepath.Walk(dir, func(fp string, info os.FileInfo, err error) error {
+		if err != nil {
+			return errors.Wrap(err, "")
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, fp)
+		if err != nil {
+			return errors.Wrap(err, "")
+		}
+		files = append(files, rel)
+		return nil
+	})
+	return
+}
+
+//isFragment tells whether fp is the path of a fragment file.
+func isFragment(fp string) bool {
+	if filepath.Base(filepath.Dir(fp)) != "fragments" {
+		return false
+	}
+	_, err := strconv.ParseUint(filepath.Base(fp), 10, 64)
+	return err == nil
+}
+
+//fileChecksum returns the CRC32 checksum of the file content.
+func fileChecksum(fp string) (sum uint32, err error) {
+	var f *os.File
+	if f, err = os.Open(fp); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	defer f.Close()
+	h := crc32.New(crcTable)
+	if _, err = io.Copy(h, f); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	sum = h.Sum32()
+	return
+}
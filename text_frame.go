@@ -21,8 +21,9 @@ type TextFrame struct {
 	index string
 	name  string
 
-	rwlock    sync.RWMutex                //concurrent access of fragments
+	rwlock    sync.RWMutex                //concurrent access of fragments, shared
 	fragments map[uint64]*pilosa.Fragment //map slice to Fragment
+	shared    map[uint64]bool             //slices whose fragment file is hard-linked to a snapshot
 	td        *TermDict
 }
 
@@ -44,6 +45,7 @@ func NewTextFrame(path, index, name string, overwrite bool) (f *TextFrame, err e
 		name:      name,
 		td:        td,
 		fragments: make(map[uint64]*pilosa.Fragment),
+		shared:    make(map[uint64]bool),
 	}
 	err = f.openFragments()
 	return
@@ -74,6 +76,10 @@ func (f *TextFrame) openFragments() (err error) {
 		}
 		f.rwlock.Lock()
 		f.fragments[slice] = fragment
+		//the file could have been linked to a snapshot before the frame was closed
+		if isLinked(fp) {
+			f.shared[slice] = true
+		}
 		f.rwlock.Unlock()
 	}
 	return
@@ -134,6 +140,7 @@ func (f *TextFrame) closeFragments() (err error) {
 	}
 	f.rwlock.Lock()
 	f.fragments = nil
+	f.shared = nil
 	f.rwlock.Unlock()
 	return
 }
@@ -148,10 +155,47 @@ func (f *TextFrame) Sync() (err error) {
 			return
 		}
 	}
+	f.shared = make(map[uint64]bool)
 	f.rwlock.Unlock()
 	return
 }
 
+// linkFragments hard-links all fragment files into the fragments directory of dir, which shall be on the same
+// file system. A linked fragment is rewritten to a new file before its next modification, so the link never changes.
+// Fragments are copied instead if the link fails.
+func (f *TextFrame) linkFragments(dir string) (err error) {
+	fragDir := filepath.Join(dir, "fragments")
+	if err = os.MkdirAll(fragDir, 0700); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	f.rwlock.Lock()
+	defer f.rwlock.Unlock()
+	var linked bool
+	for slice := range f.fragments {
+		if linked, err = linkOrCopy(f.FragmentPath(slice), filepath.Join(fragDir, strconv.FormatUint(slice, 10))); err != nil {
+			return
+		}
+		if linked {
+			f.shared[slice] = true
+		}
+	}
+	return
+}
+
+// unshare rewrites the fragment of the given slice to a new file if it's hard-linked to a snapshot. f.rwlock shall be held.
+func (f *TextFrame) unshare(slice uint64, fragment *pilosa.Fragment) (err error) {
+	if !f.shared[slice] {
+		return
+	}
+	if err = fragment.Snapshot(); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	delete(f.shared, slice)
+	return
+}
+
 // FragmentPath returns the path to a fragment
 func (f *TextFrame) FragmentPath(slice uint64) string {
 	return filepath.Join(f.path, "fragments", strconv.FormatUint(slice, 10))
@@ -183,6 +227,10 @@ func (f *TextFrame) setBit(rowID, colID uint64) (changed bool, err error) {
 		}
 		f.fragments[slice] = fragment
 	}
+	if err = f.unshare(slice, fragment); err != nil {
+		f.rwlock.Unlock()
+		return
+	}
 	f.rwlock.Unlock()
 	changed, err = fragment.SetBit(rowID, colID)
 	return
@@ -193,10 +241,19 @@ func (f *TextFrame) clearBit(rowID, colID uint64) (changed bool, err error) {
 	slice := colID / pilosa.SliceWidth
 	f.rwlock.RLock()
 	fragment, ok := f.fragments[slice]
+	shared := f.shared[slice]
 	f.rwlock.RUnlock()
 	if !ok {
 		return
 	}
+	if shared {
+		f.rwlock.Lock()
+		err = f.unshare(slice, fragment)
+		f.rwlock.Unlock()
+		if err != nil {
+			return
+		}
+	}
 	changed, err = fragment.ClearBit(rowID, colID)
 	return
 }
@@ -213,6 +270,24 @@ func (f *TextFrame) row(rowID uint64) (bm *pilosa.Bitmap) {
 	return
 }
 
+//hasBit returns whether the given bit is set.
+func (f *TextFrame) hasBit(rowID, colID uint64) bool {
+	return f.rowSlice(rowID, colID/pilosa.SliceWidth).Intersect(pilosa.NewBitmap(colID)).Count() != 0
+}
+
+//rowSlice returns the given row of the given slice as a pilosa.Bitmap.
+func (f *TextFrame) rowSlice(rowID, slice uint64) (bm *pilosa.Bitmap) {
+	f.rwlock.RLock()
+	fragment, ok := f.fragments[slice]
+	f.rwlock.RUnlock()
+	if !ok {
+		bm = pilosa.NewBitmap()
+		return
+	}
+	bm = fragment.Row(rowID)
+	return
+}
+
 // Bits returns bits set in frame.
 func (f *TextFrame) Bits() (bits map[uint64][]uint64, err error) {
 	var ok bool
@@ -278,6 +353,21 @@ func (f *TextFrame) DoIndex(docID uint64, text string) (err error) {
 	return
 }
 
+//clearDoc clears the bits of the terms of text for the given document.
+func (f *TextFrame) clearDoc(docID uint64, text string) (err error) {
+	for _, word := range ParseWords(text) {
+		termID, found := f.td.GetTermID(word)
+		if !found {
+			continue
+		}
+		if _, err = f.clearBit(termID, docID); err != nil {
+			return
+		}
+	}
+	return
+}
+
+
 //Query query which documents contain the given term.
 func (f *TextFrame) Query(text string) (bm *pilosa.Bitmap) {
 	words := ParseWords(text)
@@ -298,6 +388,20 @@ func (f *TextFrame) Query(text string) (bm *pilosa.Bitmap) {
 	return
 }
 
+//termIDs returns the term IDs of the words of text. found is false if any word is not in the dictionary.
+func (f *TextFrame) termIDs(text string) (ids []uint64, found bool) {
+	words := ParseWords(text)
+	for _, word := range words {
+		termID, ok := f.td.GetTermID(word)
+		if !ok {
+			return
+		}
+		ids = append(ids, termID)
+	}
+	found = true
+	return
+}
+
 // GetFragList returns fragments' numbers
 func (f *TextFrame) GetFragList() (numList []uint64) {
 	numList = make([]uint64, len(f.fragments))
@@ -318,8 +422,26 @@ var asciiSpace = [128]uint8{'\t': 1, '\n': 1, '\v': 1, '\f': 1, '\r': 1, ' ': 1}
 //A word is a non-ascii-space lowered ASCII character sequence, or a non-ASCII non-unicode-space non-chinese-punctuate character.
 //Note: words are not de-duplicated.
 func ParseWords(text string) (words []string) {
+	words, _ = parseWords(text, false)
+	return
+}
+
+//WordOffset is the byte range [Start, End) of a word in the parsed text.
+type WordOffset struct {
+	Start, End int
+}
+
+//ParseWordsWithOffsets is the same as ParseWords, and also returns the offset of each word in text.
+func ParseWordsWithOffsets(text string) (words []string, offsets []WordOffset) {
+	return parseWords(text, true)
+}
+
+func parseWords(text string, withOffsets bool) (words []string, offsets []WordOffset) {
 	lenText := len(text)
 	words = make([]string, 0, lenText/3)
+	if withOffsets {
+		offsets = make([]WordOffset, 0, lenText/3)
+	}
 	i := 0
 	for i < lenText {
 		j := i
@@ -334,6 +456,9 @@ func ParseWords(text string) (words []string) {
 		if i < j {
 			// text[i:j] is a printable non-space ASCII character sequence.
 			words = append(words, strings.ToLower(text[i:j]))
+			if withOffsets {
+				offsets = append(offsets, WordOffset{Start: i, End: j})
+			}
 			i = j
 		} else if c < 0x80 {
 			// i==j, text[i] is an ascii space, non-printable or punctuation character.
@@ -343,6 +468,9 @@ func ParseWords(text string) (words []string) {
 			r, w := utf8.DecodeRuneInString(text[i:])
 			if unicode.IsPrint(rune(c)) && !unicode.IsSpace(r) && !unicode.IsPunct(r) {
 				words = append(words, text[i:i+w])
+				if withOffsets {
+					offsets = append(offsets, WordOffset{Start: i, End: i + w})
+				}
 			}
 			i += w
 		}
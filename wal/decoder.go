@@ -16,9 +16,12 @@ package wal
 
 import (
 	"bufio"
+	"bytes"
+	"compress/flate"
 	"encoding/binary"
 	"hash"
 	"io"
+	"io/ioutil"
 	"sync"
 
 	"github.com/coreos/etcd/pkg/crc"
@@ -39,6 +42,9 @@ type decoder struct {
 	// lastValidOff file offset following the last valid decoded record
 	lastValidOff int64
 	crc          hash.Hash32
+	// format of the current segment
+	format byte
+	zr     io.ReadCloser
 }
 
 func newDecoder(r ...io.Reader) *decoder {
@@ -97,7 +103,24 @@ func (d *decoder) decodeRecord(rec *walpb.Record) error {
 	}
 
 	// skip crc checking if the record type is crcType
-	if rec.Type != crcType {
+	if rec.Type == crcType {
+		// every segment begins with a crc record which tells the format
+		d.format = formatPlain
+		if len(rec.Data) != 0 {
+			d.format = rec.Data[0]
+		}
+		if d.format != formatPlain && d.format != formatFlate {
+			return errors.Wrapf(ErrUnknownFormat, "format %d", d.format)
+		}
+	} else {
+		if d.format == formatFlate {
+			if rec.Data, err = d.decompress(rec.Data); err != nil {
+				if d.isTornEntry(data) {
+					return errors.Wrap(io.ErrUnexpectedEOF, "")
+				}
+				return err
+			}
+		}
 		d.crc.Write(rec.Data)
 		if err := rec.Validate(d.crc.Sum32()); err != nil {
 			if d.isTornEntry(data) {
@@ -111,6 +134,19 @@ func (d *decoder) decodeRecord(rec *walpb.Record) error {
 	return nil
 }
 
+func (d *decoder) decompress(data []byte) (out []byte, err error) {
+	if d.zr == nil {
+		d.zr = flate.NewReader(bytes.NewReader(data))
+	} else if err = d.zr.(flate.Resetter).Reset(bytes.NewReader(data), nil); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	if out, err = ioutil.ReadAll(d.zr); err != nil {
+		err = errors.Wrap(err, "")
+	}
+	return
+}
+
 func decodeFrameSize(lenField int64) (recBytes int64, padBytes int64) {
 	// the record size is stored in the lower 56 bits of the 64-bit length
 	recBytes = int64(uint64(lenField) & ^(uint64(0xff) << 56))
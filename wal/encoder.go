@@ -15,6 +15,8 @@
 package wal
 
 import (
+	"bytes"
+	"compress/flate"
 	"encoding/binary"
 	"hash"
 	"io"
@@ -32,6 +34,14 @@ import (
 // distinguish between torn writes and ordinary data corruption.
 const walPageBytes = 8 * minSectorSize
 
+// Formats of a segment, which are stored in the data of the crc record at the beginning of the segment.
+// A segment written before the format is introduced has no data in its crc record, which means formatPlain.
+const (
+	formatPlain byte = iota
+	// data of the other records are compressed with flate
+	formatFlate
+)
+
 type encoder struct {
 	mu sync.Mutex
 	bw *ioutil.PageWriter
@@ -40,6 +50,10 @@ type encoder struct {
 	buf       []byte
 	uint64buf []byte
 	curOff    int64 //offset of under-layer io.File
+
+	compress bool          //whether to compress data of the non-crc records
+	zw       *flate.Writer //compressor, created on demand
+	zbuf     bytes.Buffer  //compressed data
 }
 
 func newEncoder(w io.Writer, prevCrc uint32, pageOffset int) *encoder {
@@ -66,12 +80,21 @@ func (e *encoder) encode(rec *walpb.Record) (err error) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
 
-	e.crc.Write(rec.Data)
+	// crc is always calculated over the uncompressed data, and crc records are excluded
+	if rec.Type != crcType {
+		e.crc.Write(rec.Data)
+	}
 	rec.Crc = e.crc.Sum32()
 	var (
 		data []byte
 		n    int
 	)
+	if e.compress && rec.Type != crcType {
+		if err = e.compressData(rec.Data); err != nil {
+			return
+		}
+		rec.Data = e.zbuf.Bytes()
+	}
 
 	if rec.Size() > len(e.buf) {
 		if data, err = rec.Marshal(); err != nil {
@@ -103,6 +126,26 @@ func (e *encoder) encode(rec *walpb.Record) (err error) {
 	return
 }
 
+func (e *encoder) compressData(data []byte) (err error) {
+	e.zbuf.Reset()
+	if e.zw == nil {
+		if e.zw, err = flate.NewWriter(&e.zbuf, flate.BestSpeed); err != nil {
+			err = errors.Wrap(err, "")
+			return
+		}
+	} else {
+		e.zw.Reset(&e.zbuf)
+	}
+	if _, err = e.zw.Write(data); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	if err = e.zw.Close(); err != nil {
+		err = errors.Wrap(err, "")
+	}
+	return
+}
+
 func encodeFrameSize(dataBytes int) (lenField uint64, padBytes int) {
 	lenField = uint64(dataBytes)
 	// force 8 byte alignment so length never gets a torn write
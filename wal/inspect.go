@@ -0,0 +1,77 @@
+package wal
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/deepfabric/indexer/wal/walpb"
+	"github.com/pkg/errors"
+)
+
+// RecordTypeName returns the name of a record type, such as "crc" and "entry".
+func RecordTypeName(typ int64) string {
+	switch typ {
+	case metadataType:
+		return "metadata"
+	case entryType:
+		return "entry"
+	case stateType:
+		return "state"
+	case crcType:
+		return "crc"
+	case snapshotType:
+		return "snapshot"
+	default:
+		return fmt.Sprintf("unknown(%d)", typ)
+	}
+}
+
+// FirstIndex returns the index in the name of the first segment, which is 0 unless the leading segments have been released.
+// OpenForRead with a snapshot at this index reads all remaining segments.
+func FirstIndex(dirpath string) (index uint64, err error) {
+	var names []string
+	if names, err = readWalNames(dirpath); err != nil {
+		return
+	}
+	_, index, err = parseWalName(names[0])
+	return
+}
+
+// ReadRecords calls fn with every record of a WAL opened by OpenForRead, in order, for inspection.
+// rec is reused between calls. The data of compressed records are decompressed, and entry records are decoded into ent,
+// which is nil for other records.
+// Unlike ReadAll, entries are not checked for order. It stops at the first error of fn or the decoder, and a torn
+// write at the tail is reported as io.ErrUnexpectedEOF after all records before it are passed to fn.
+func (w *WAL) ReadRecords(fn func(rec *walpb.Record, ent *walpb.Entry) error) (err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	rec := &walpb.Record{}
+	decoder := w.decoder
+	for err = decoder.decode(rec); err == nil; err = decoder.decode(rec) {
+		var ent *walpb.Entry
+		switch rec.Type {
+		case entryType:
+			ent = &walpb.Entry{}
+			if err = ent.Unmarshal(rec.Data); err != nil {
+				err = errors.Wrap(err, "")
+				return
+			}
+		case crcType:
+			crc := decoder.crc.Sum32()
+			// do no need to match 0 crc, since the decoder is a new one at this case.
+			if crc != 0 && rec.Validate(crc) != nil {
+				err = errors.Wrap(ErrCRCMismatch, "")
+				return
+			}
+			decoder.updateCRC(rec.Crc)
+		}
+		if err = fn(rec, ent); err != nil {
+			return
+		}
+	}
+	if errors.Cause(err) == io.EOF {
+		err = nil
+	}
+	return
+}
@@ -0,0 +1,103 @@
+package wal
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/coreos/etcd/pkg/fileutil"
+	"github.com/deepfabric/indexer/wal/walpb"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Repair truncates the last segment of the WAL at the end of its last valid record, so that a torn write
+// at the tail doesn't prevent the WAL from being opened. It returns the number of records and bytes discarded.
+// Corruptions other than a torn tail, and corruptions in the other segments, are not repaired.
+func Repair(dirpath string) (records int, bytes int64, err error) {
+	var names []string
+	if names, err = readWalNames(dirpath); err != nil {
+		return
+	}
+	fpath := filepath.Join(dirpath, names[len(names)-1])
+	var f *fileutil.LockedFile
+	if f, err = fileutil.LockFile(fpath, os.O_RDWR, fileutil.PrivateFileMode); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	defer f.Close()
+
+	rec := &walpb.Record{}
+	decoder := newDecoder(f)
+	for {
+		lastOffset := decoder.lastOffset()
+		err = decoder.decode(rec)
+		switch errors.Cause(err) {
+		case nil:
+			if rec.Type == crcType {
+				crc := decoder.crc.Sum32()
+				// do no need to match 0 crc, since the decoder is a new one at this case.
+				if crc != 0 && rec.Validate(crc) != nil {
+					err = errors.Wrap(ErrCRCMismatch, "")
+					return
+				}
+				decoder.updateCRC(rec.Crc)
+			}
+			continue
+		case io.EOF:
+			err = nil
+			return
+		case io.ErrUnexpectedEOF:
+			if records, bytes, err = countTornRecords(f.File, lastOffset); err != nil {
+				return
+			}
+			if err = f.Truncate(lastOffset); err != nil {
+				err = errors.Wrap(err, "")
+				return
+			}
+			if err = fileutil.Fsync(f.File); err != nil {
+				err = errors.Wrap(err, "")
+				return
+			}
+			log.Warnf("repaired wal file %v, truncated at %v, discarded %v records of %v bytes", fpath, lastOffset, records, bytes)
+			return
+		default:
+			return
+		}
+	}
+}
+
+// countTornRecords counts the records following off, until the preallocated space or the end of f.
+func countTornRecords(f *os.File, off int64) (records int, bytes int64, err error) {
+	var fi os.FileInfo
+	if fi, err = f.Stat(); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	if _, err = f.Seek(off, io.SeekStart); err != nil {
+		err = errors.Wrap(err, "")
+		return
+	}
+	end := off
+	for end+frameSizeBytes <= fi.Size() {
+		var l int64
+		if l, err = readInt64(f); err != nil {
+			return
+		}
+		if l == 0 {
+			break
+		}
+		recBytes, padBytes := decodeFrameSize(l)
+		end += frameSizeBytes + recBytes + padBytes
+		records++
+		if _, err = f.Seek(end, io.SeekStart); err != nil {
+			err = errors.Wrap(err, "")
+			return
+		}
+	}
+	if end > fi.Size() {
+		end = fi.Size()
+	}
+	bytes = end - off
+	return
+}
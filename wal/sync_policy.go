@@ -0,0 +1,147 @@
+package wal
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// SyncMode controls when the saved entries are synced to disk.
+type SyncMode int
+
+const (
+	// SyncEveryOp syncs on every Save and SaveEntry call. It's the default mode.
+	SyncEveryOp SyncMode = iota
+	// SyncGroup coalesces concurrent Save and SaveEntry callers into one flush plus one sync.
+	// Every caller still returns after its entries are synced.
+	SyncGroup
+	// SyncPeriodic flushes entries to the OS on every call, and syncs them in background periodically.
+	// Entries saved since the last sync may be lost on power failure.
+	SyncPeriodic
+	// SyncNone flushes entries to the OS on every call, and syncs only when a segment is cut or the WAL is closed.
+	SyncNone
+)
+
+const (
+	// DefaultGroupCommitDelay is the default value for SyncPolicy.MaxDelay.
+	DefaultGroupCommitDelay = 2 * time.Millisecond
+	// DefaultGroupCommitBatch is the default value for SyncPolicy.MaxBatch.
+	DefaultGroupCommitBatch = 128
+	// DefaultSyncInterval is the default value for SyncPolicy.Interval.
+	DefaultSyncInterval = time.Second
+)
+
+// SyncPolicy controls how the WAL syncs the saved entries.
+type SyncPolicy struct {
+	Mode SyncMode
+	// MaxDelay is the maximum time the first caller of a group waits for others. Used by SyncGroup.
+	MaxDelay time.Duration
+	// MaxBatch is the number of callers after which a group is committed at once. Used by SyncGroup.
+	MaxBatch int
+	// Interval is the time between two background syncs. Used by SyncPeriodic.
+	Interval time.Duration
+}
+
+// SetSyncPolicy changes the sync policy. Pending group commits are committed before the change.
+func (w *WAL) SetSyncPolicy(policy SyncPolicy) {
+	if policy.MaxDelay <= 0 {
+		policy.MaxDelay = DefaultGroupCommitDelay
+	}
+	if policy.MaxBatch <= 0 {
+		policy.MaxBatch = DefaultGroupCommitBatch
+	}
+	if policy.Interval <= 0 {
+		policy.Interval = DefaultSyncInterval
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.groupCommit()
+	w.stopPeriodicSync()
+	w.policy = policy
+	if policy.Mode == SyncPeriodic {
+		w.stopc = make(chan struct{})
+		go w.syncPeriodically(policy.Interval, w.stopc)
+	}
+}
+
+// commit makes the entries just saved durable according to the sync policy. w.mu shall be held.
+// If ch is not nil, the caller shall receive the result from it after releasing w.mu.
+func (w *WAL) commit() (ch chan error, err error) {
+	if w.policy.Mode == SyncGroup {
+		ch = make(chan error, 1)
+		w.pending = append(w.pending, ch)
+		if len(w.pending) < w.policy.MaxBatch && w.encoder.curOff < SegmentSizeBytes {
+			if w.timer == nil {
+				w.timer = time.AfterFunc(w.policy.MaxDelay, w.groupCommitTimeout)
+			}
+			return
+		}
+		w.groupCommit()
+		return
+	}
+	if w.encoder.curOff >= SegmentSizeBytes {
+		err = w.cut()
+		return
+	}
+	switch w.policy.Mode {
+	case SyncPeriodic, SyncNone:
+		err = w.encoder.flush()
+	default:
+		err = w.Sync()
+	}
+	return
+}
+
+// groupCommit syncs the WAL and notifies all pending callers of the result. w.mu shall be held.
+func (w *WAL) groupCommit() {
+	if w.timer != nil {
+		w.timer.Stop()
+		w.timer = nil
+	}
+	if len(w.pending) == 0 {
+		return
+	}
+	var err error
+	if w.encoder.curOff >= SegmentSizeBytes {
+		err = w.cut()
+	} else {
+		err = w.Sync()
+	}
+	for _, ch := range w.pending {
+		ch <- err
+	}
+	w.pending = nil
+}
+
+func (w *WAL) groupCommitTimeout() {
+	w.mu.Lock()
+	w.groupCommit()
+	w.mu.Unlock()
+}
+
+// stopPeriodicSync stops the background sync goroutine if there is. w.mu shall be held.
+func (w *WAL) stopPeriodicSync() {
+	if w.stopc != nil {
+		close(w.stopc)
+		w.stopc = nil
+	}
+}
+
+func (w *WAL) syncPeriodically(interval time.Duration, stopc chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopc:
+			return
+		case <-ticker.C:
+			w.mu.Lock()
+			if w.tail != nil && w.encoder != nil {
+				if err := w.Sync(); err != nil {
+					log.Errorf("wal %v periodic sync failed: %+v", w.dir, err)
+				}
+			}
+			w.mu.Unlock()
+		}
+	}
+}
@@ -49,9 +49,11 @@ var (
 	// so that tests can set a different segment size.
 	SegmentSizeBytes int64 = 64 * 1000 * 1000 // 64MB
 
-	ErrFileNotFound = errors.New("wal: file not found")
-	ErrCRCMismatch  = errors.New("wal: crc mismatch")
-	crcTable        = crc32.MakeTable(crc32.Castagnoli)
+	ErrFileNotFound  = errors.New("wal: file not found")
+	ErrCRCMismatch   = errors.New("wal: crc mismatch")
+	ErrOutOfOrder    = errors.New("wal: entry index out of order")
+	ErrUnknownFormat = errors.New("wal: unknown segment format")
+	crcTable         = crc32.MakeTable(crc32.Castagnoli)
 )
 
 // WAL is a logical representation of the stable storage.
@@ -71,15 +73,33 @@ type WAL struct {
 
 	mu      sync.Mutex
 	enti    uint64   // index of the last entry saved to the wal
+	rewind  bool     // whether the next entry may rewrite the log from an earlier index, true right after ReadAll
 	encoder *encoder // encoder to encode records
 
 	tail     *os.File //the tail segment
 	walNames []string // the segment files the WAL holds (the name is increasing)
 	fp       *filePipeline
+
+	compress bool // whether to compress the segments created afterwards
+
+	policy  SyncPolicy
+	pending []chan error  // callers waiting for the current group commit
+	timer   *time.Timer   // fires the current group commit
+	stopc   chan struct{} // stops the periodic sync goroutine
+}
+
+// Options are the options of a WAL which take effect from its first segment.
+type Options struct {
+	Compress bool // whether to compress the entries of the segments created
 }
 
 // Create creates a WAL ready for appending records.
 func Create(dirpath string) (*WAL, error) {
+	return CreateExt(dirpath, Options{})
+}
+
+// CreateExt creates a WAL ready for appending records with the given options.
+func CreateExt(dirpath string, opts Options) (*WAL, error) {
 	if Exist(dirpath) {
 		return nil, os.ErrExist
 	}
@@ -110,6 +130,7 @@ func Create(dirpath string) (*WAL, error) {
 	w := &WAL{
 		dir:      dirpath,
 		walNames: make([]string, 0),
+		compress: opts.Compress,
 	}
 	if w, err = w.renameWal(tmpdirpath); err != nil {
 		return nil, err
@@ -263,11 +284,28 @@ func openAtIndex(dirpath string, snap walpb.Snapshot, write bool) (*WAL, error)
 // The WAL cannot be appended to before reading out all of its
 // previous records.
 func OpenAtBeginning(dirpath string) (*WAL, error) {
+	return OpenAtBeginningExt(dirpath, Options{})
+}
+
+// OpenAtBeginningExt opens the WAL at the beginning with the given options.
+// The options apply to the segments created by the following ReadAll and appending.
+func OpenAtBeginningExt(dirpath string, opts Options) (*WAL, error) {
 	names, err := readWalNames(dirpath)
 	if err != nil && errors.Cause(err) != ErrFileNotFound {
 		return nil, err
 	}
 	nameIndex := 0
+	// entries before the first segment have been compacted
+	var enti uint64
+	if len(names) != 0 {
+		var index uint64
+		if _, index, err = parseWalName(names[0]); err != nil {
+			return nil, err
+		}
+		if index != 0 {
+			enti = index - 1
+		}
+	}
 
 	// open the wal files
 	rcs := make([]io.ReadCloser, 0)
@@ -293,7 +331,9 @@ func OpenAtBeginning(dirpath string) (*WAL, error) {
 		start:     walpb.Snapshot{},
 		decoder:   newDecoder(rs...),
 		readClose: closer,
+		enti:      enti,
 		walNames:  walNames,
+		compress:  opts.Compress,
 	}
 
 	w.fp = newFilePipeline(w.dir, SegmentSizeBytes)
@@ -312,6 +352,8 @@ func OpenAtBeginning(dirpath string) (*WAL, error) {
 // all the records and error ErrSnapshotMismatch.
 // TODO: detect not-last-snap error.
 // TODO: maybe loose the checking of match.
+// An entry whose index isn't greater than the previous one's is rejected with ErrOutOfOrder,
+// unless it begins a segment. Such a segment rewrites the log from the entry on, see Save.
 // After ReadAll, the WAL will be ready for appending new records.
 func (w *WAL) ReadAll() (ents []walpb.Entry, err error) {
 	w.mu.Lock()
@@ -319,13 +361,28 @@ func (w *WAL) ReadAll() (ents []walpb.Entry, err error) {
 
 	rec := &walpb.Record{}
 	decoder := w.decoder
+	// every segment begins with a crc record
+	segBegin := false
 
 	for err = decoder.decode(rec); err == nil; err = decoder.decode(rec) {
 		switch rec.Type {
 		case entryType:
 			e := mustUnmarshalEntry(rec.Data)
+			if w.enti != 0 && e.Index <= w.enti {
+				if !segBegin {
+					err = errors.Wrapf(ErrOutOfOrder, "entry index %d follows %d", e.Index, w.enti)
+					return
+				}
+				// the segment supersedes the previous entries from e.Index on
+				n := len(ents)
+				for n > 0 && ents[n-1].Index >= e.Index {
+					n--
+				}
+				ents = ents[:n]
+			}
+			segBegin = false
 			if e.Index > w.start.Index {
-				ents = append(ents[:e.Index-w.start.Index-1], e)
+				ents = append(ents, e)
 			}
 			w.enti = e.Index
 		case crcType:
@@ -337,6 +394,7 @@ func (w *WAL) ReadAll() (ents []walpb.Entry, err error) {
 				return
 			}
 			decoder.updateCRC(rec.Crc)
+			segBegin = true
 		default:
 		}
 	}
@@ -361,6 +419,8 @@ func (w *WAL) ReadAll() (ents []walpb.Entry, err error) {
 		if err = w.advance(w.decoder.lastCRC()); err != nil {
 			return
 		}
+		// entries lost in a torn tail may be written again
+		w.rewind = true
 	}
 	// close decoder, disable reading
 	if w.readClose != nil {
@@ -394,6 +454,7 @@ func (w *WAL) cut() error {
 func (w *WAL) CompactAll() (err error) {
 	w.mu.Lock()
 	defer w.mu.Unlock()
+	w.groupCommit()
 	if err = w.clean(); err != nil {
 		return
 	}
@@ -402,6 +463,44 @@ func (w *WAL) CompactAll() (err error) {
 	return
 }
 
+// ReleaseTo removes the segments whose entries are all at or before index, i.e. they are no longer needed for recovery.
+// The tail segment is cut first if all of its entries are at or before index.
+func (w *WAL) ReleaseTo(index uint64) (err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var segIndex uint64
+	if w.tail != nil && len(w.walNames) != 0 && w.enti <= index {
+		if _, segIndex, err = parseWalName(filepath.Base(w.walNames[len(w.walNames)-1])); err != nil {
+			return
+		}
+		// cut only if the tail contains entries
+		if segIndex <= w.enti {
+			w.groupCommit()
+			if err = w.cut(); err != nil {
+				return
+			}
+		}
+	}
+	// segment i contains entries before the first index of segment i+1
+	var i int
+	for ; i+1 < len(w.walNames); i++ {
+		if _, segIndex, err = parseWalName(filepath.Base(w.walNames[i+1])); err != nil {
+			return
+		}
+		if segIndex > index+1 {
+			break
+		}
+		if err = os.Remove(w.walNames[i]); err != nil {
+			w.walNames = w.walNames[i:]
+			err = errors.Wrap(err, "")
+			return
+		}
+		log.Infof("released wal file %v", w.walNames[i])
+	}
+	w.walNames = w.walNames[i:]
+	return
+}
+
 // CompactAll remove all entries.
 func (w *WAL) clean() (err error) {
 	for _, name := range w.walNames {
@@ -474,6 +573,7 @@ func (w *WAL) advance(prevCrc uint32) (err error) {
 	if err != nil {
 		return errors.Wrap(err, "")
 	}
+	w.encoder.compress = w.compress
 
 	log.Infof("segmented wal file %v is created", fpath)
 	return nil
@@ -493,6 +593,8 @@ func (w *WAL) Close(clean bool) (err error) {
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
+	w.groupCommit()
+	w.stopPeriodicSync()
 	if w.fp != nil {
 		w.fp.Close()
 		w.fp = nil
@@ -527,26 +629,35 @@ func (w *WAL) Close(clean bool) (err error) {
 	return
 }
 
-// SaveEntry saves an entry, and always sync the wal
+// SaveEntry saves an entry, and syncs the wal according to the sync policy
 func (w *WAL) SaveEntry(e *walpb.Entry) (err error) {
+	var ch chan error
 	w.mu.Lock()
-	defer w.mu.Unlock()
 	if err = w.saveEntry(e); err != nil {
+		w.mu.Unlock()
 		return
 	}
-	if w.encoder.curOff >= SegmentSizeBytes {
-		if err = w.cut(); err != nil {
-			return
-		}
-	} else {
-		if err = w.Sync(); err != nil {
-			return
-		}
+	ch, err = w.commit()
+	w.mu.Unlock()
+	if ch != nil {
+		err = <-ch
 	}
 	return
 }
 
 func (w *WAL) saveEntry(e *walpb.Entry) (err error) {
+	if w.enti != 0 && e.Index <= w.enti {
+		if !w.rewind || e.Index == 0 {
+			err = errors.Wrapf(ErrOutOfOrder, "entry index %d follows %d", e.Index, w.enti)
+			return
+		}
+		// rewrite the log from e.Index in a new segment, which ReadAll lets supersede the previous entries
+		w.enti = e.Index - 1
+		if err = w.cut(); err != nil {
+			return
+		}
+	}
+	w.rewind = false
 	// TODO: add MustMarshalTo to reduce one allocation.
 	b := pbutil.MustMarshal(e)
 	rec := &walpb.Record{Type: entryType, Data: b}
@@ -557,36 +668,98 @@ func (w *WAL) saveEntry(e *walpb.Entry) (err error) {
 	return
 }
 
+// Save saves entries, and syncs the wal according to the sync policy
+// Right after ReadAll, the first entry may rewrite the log from an index not after the last one,
+// e.g. to write the entries lost in a torn tail again. Other out-of-order entries are rejected with ErrOutOfOrder.
 func (w *WAL) Save(ents []walpb.Entry) (err error) {
-	w.mu.Lock()
-	defer w.mu.Unlock()
-
 	// short cut, do not call sync
 	if len(ents) == 0 {
 		return
 	}
 
+	var ch chan error
+	w.mu.Lock()
 	// TODO(xiangli): no more reference operator
 	for i := range ents {
 		if err = w.saveEntry(&ents[i]); err != nil {
+			w.mu.Unlock()
 			return
 		}
 	}
+	ch, err = w.commit()
+	w.mu.Unlock()
+	if ch != nil {
+		err = <-ch
+	}
+	return
+}
 
-	if w.encoder.curOff >= SegmentSizeBytes {
-		if err = w.cut(); err != nil {
-			return
-		}
-	} else {
-		if err = w.Sync(); err != nil {
+// Append assigns consecutive indices following the last saved entry to ents, saves them,
+// and syncs the wal according to the sync policy. Concurrent callers shall use it instead of
+// Save, so that the indices are assigned in the order the entries are written.
+func (w *WAL) Append(ents []walpb.Entry) (lastIndex uint64, err error) {
+	var ch chan error
+	w.mu.Lock()
+	lastIndex = w.enti
+	if len(ents) == 0 {
+		w.mu.Unlock()
+		return
+	}
+	for i := range ents {
+		ents[i].Index = w.enti + 1
+		if err = w.saveEntry(&ents[i]); err != nil {
+			w.mu.Unlock()
 			return
 		}
 	}
+	lastIndex = w.enti
+	ch, err = w.commit()
+	w.mu.Unlock()
+	if ch != nil {
+		err = <-ch
+	}
+	return
+}
+
+// LastIndex returns the index of the last entry saved to or read from the wal.
+func (w *WAL) LastIndex() (index uint64) {
+	w.mu.Lock()
+	index = w.enti
+	w.mu.Unlock()
 	return
 }
 
+// SetLastIndex moves the last entry index forward, so that the following entries are indexed after it.
+// It's used when the entries up to index were persisted elsewhere and removed from the wal.
+func (w *WAL) SetLastIndex(index uint64) (err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if index < w.enti {
+		err = errors.Wrapf(ErrOutOfOrder, "last index %d is behind %d", index, w.enti)
+		return
+	}
+	w.enti = index
+	return
+}
+
+// SetCompression sets whether to compress the entries of the segments created afterwards.
+// Segments of both formats are readable regardless of it.
+func (w *WAL) SetCompression(compress bool) {
+	w.mu.Lock()
+	w.compress = compress
+	w.mu.Unlock()
+}
+
+// saveCrc writes the crc record which begins a segment, and sets the segment format.
 func (w *WAL) saveCrc(prevCrc uint32) (err error) {
-	err = w.encoder.encode(&walpb.Record{Type: crcType, Crc: prevCrc})
+	rec := &walpb.Record{Type: crcType, Crc: prevCrc}
+	if w.compress {
+		rec.Data = []byte{formatFlate}
+	}
+	if err = w.encoder.encode(rec); err != nil {
+		return
+	}
+	w.encoder.compress = w.compress
 	return
 }
 
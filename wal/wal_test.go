@@ -20,12 +20,15 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
 	"github.com/coreos/etcd/pkg/fileutil"
+	"github.com/coreos/etcd/pkg/pbutil"
 	"github.com/deepfabric/indexer/wal/walpb"
 )
 
@@ -469,3 +472,339 @@ func TestOpenOnTornWrite(t *testing.T) {
 	wEntries := (clobberIdx - 1) + overwriteEntries
 	require.Equal(t, wEntries, len(ents))
 }
+
+func TestSyncPolicy(t *testing.T) {
+	policies := []SyncPolicy{
+		{Mode: SyncEveryOp},
+		{Mode: SyncGroup, MaxDelay: time.Millisecond, MaxBatch: 8},
+		{Mode: SyncPeriodic, Interval: time.Millisecond},
+		{Mode: SyncNone},
+	}
+	numWriters := 10
+	numEntsPerWriter := 50
+	for i, policy := range policies {
+		p, err := ioutil.TempDir(os.TempDir(), "waltest")
+		require.NoError(t, err)
+
+		w, err := Create(p)
+		require.NoError(t, err)
+		w.SetSyncPolicy(policy)
+		var wg sync.WaitGroup
+		for j := 0; j < numWriters; j++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for k := 0; k < numEntsPerWriter; k++ {
+					_, err := w.Append([]walpb.Entry{{Data: []byte("somedata")}})
+					require.NoErrorf(t, err, "case %d", i)
+				}
+			}()
+		}
+		wg.Wait()
+		err = w.Close(false)
+		require.NoError(t, err)
+
+		w, err = Open(p, walpb.Snapshot{})
+		require.NoError(t, err)
+		ents, err := w.ReadAll()
+		require.NoError(t, err)
+		require.Equalf(t, numWriters*numEntsPerWriter, len(ents), "case %d", i)
+		for j, e := range ents {
+			require.Equalf(t, uint64(j+1), e.Index, "case %d", i)
+		}
+		w.Close(false)
+		os.RemoveAll(p)
+	}
+}
+
+func TestEntryIndex(t *testing.T) {
+	p, err := ioutil.TempDir(os.TempDir(), "waltest")
+	require.NoError(t, err)
+	defer os.RemoveAll(p)
+
+	w, err := Create(p)
+	require.NoError(t, err)
+	for i := 1; i <= 5; i++ {
+		err = w.SaveEntry(&walpb.Entry{Index: uint64(i)})
+		require.NoError(t, err)
+	}
+	//TESTCASE: out-of-order entries are rejected
+	err = w.SaveEntry(&walpb.Entry{Index: uint64(3)})
+	require.Equal(t, ErrOutOfOrder, errors.Cause(err))
+	err = w.SaveEntry(&walpb.Entry{Index: uint64(5)})
+	require.Equal(t, ErrOutOfOrder, errors.Cause(err))
+	lastIndex, err := w.Append(make([]walpb.Entry, 2))
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), lastIndex)
+
+	//TESTCASE: the last index survives compaction
+	err = w.CompactAll()
+	require.NoError(t, err)
+	err = w.Close(false)
+	require.NoError(t, err)
+	w, err = OpenAtBeginning(p)
+	require.NoError(t, err)
+	ents, err := w.ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(ents))
+	require.Equal(t, uint64(7), w.LastIndex())
+	err = w.SetLastIndex(uint64(6))
+	require.Equal(t, ErrOutOfOrder, errors.Cause(err))
+	err = w.SetLastIndex(uint64(10))
+	require.NoError(t, err)
+	lastIndex, err = w.Append(make([]walpb.Entry, 1))
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), lastIndex)
+	err = w.Close(false)
+	require.NoError(t, err)
+
+	//TESTCASE: right after ReadAll, the first entry may rewrite the log from an earlier index
+	w, err = OpenAtBeginning(p)
+	require.NoError(t, err)
+	ents, err = w.ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(ents))
+	err = w.SaveEntry(&walpb.Entry{Index: uint64(11), Data: []byte("new")})
+	require.NoError(t, err)
+	err = w.SaveEntry(&walpb.Entry{Index: uint64(11)})
+	require.Equal(t, ErrOutOfOrder, errors.Cause(err))
+	err = w.Close(false)
+	require.NoError(t, err)
+
+	//TESTCASE: out-of-order entries on disk are rejected by ReadAll
+	w, err = OpenAtBeginning(p)
+	require.NoError(t, err)
+	ents, err = w.ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(ents))
+	require.Equal(t, []byte("new"), ents[0].Data)
+	w.encoder.encode(&walpb.Record{Type: entryType, Data: pbutil.MustMarshal(&walpb.Entry{Index: uint64(12)})})
+	w.encoder.encode(&walpb.Record{Type: entryType, Data: pbutil.MustMarshal(&walpb.Entry{Index: uint64(4)})})
+	err = w.Close(false)
+	require.NoError(t, err)
+	w, err = OpenAtBeginning(p)
+	require.NoError(t, err)
+	_, err = w.ReadAll()
+	require.Equal(t, ErrOutOfOrder, errors.Cause(err))
+	w.Close(false)
+}
+
+func TestReleaseTo(t *testing.T) {
+	p, err := ioutil.TempDir(os.TempDir(), "waltest")
+	require.NoError(t, err)
+	defer os.RemoveAll(p)
+
+	w, err := Create(p)
+	require.NoError(t, err)
+	// segments of entries [1, 5], [6, 10] and [11, 12]
+	for i := 1; i <= 12; i++ {
+		err = w.SaveEntry(&walpb.Entry{Index: uint64(i)})
+		require.NoError(t, err)
+		if i == 5 || i == 10 {
+			err = w.cut()
+			require.NoError(t, err)
+		}
+	}
+	require.Equal(t, 3, len(w.walNames))
+
+	//TESTCASE: a segment which contains entries after index is kept
+	err = w.ReleaseTo(7)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(w.walNames))
+	names, err := readWalNames(p)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(names))
+
+	//TESTCASE: the tail is cut if all of its entries are released
+	err = w.ReleaseTo(12)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(w.walNames))
+	err = w.SaveEntry(&walpb.Entry{Index: uint64(13)})
+	require.NoError(t, err)
+	err = w.Close(false)
+	require.NoError(t, err)
+
+	w, err = OpenAtBeginning(p)
+	require.NoError(t, err)
+	ents, err := w.ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(ents))
+	require.Equal(t, uint64(13), ents[0].Index)
+	w.Close(false)
+}
+
+func TestRepair(t *testing.T) {
+	p, err := ioutil.TempDir(os.TempDir(), "waltest")
+	require.NoError(t, err)
+	defer os.RemoveAll(p)
+
+	w, err := Create(p)
+	require.NoError(t, err)
+	for i := 1; i <= 9; i++ {
+		err = w.SaveEntry(&walpb.Entry{Index: uint64(i), Data: []byte{byte(i)}})
+		require.NoError(t, err)
+	}
+	start, err := w.tail.Seek(0, io.SeekCurrent)
+	require.NoError(t, err)
+	err = w.SaveEntry(&walpb.Entry{Index: uint64(10), Data: bytes.Repeat([]byte{0xff}, 4096)})
+	require.NoError(t, err)
+	end, err := w.tail.Seek(0, io.SeekCurrent)
+	require.NoError(t, err)
+	fn := filepath.Join(p, filepath.Base(w.tail.Name()))
+	w.Close(false)
+
+	//TESTCASE: a healthy WAL is kept intact
+	records, discarded, err := Repair(p)
+	require.NoError(t, err)
+	require.Equal(t, 0, records)
+	require.Equal(t, int64(0), discarded)
+
+	// zero a sector inside the last record to simulate a torn write
+	f, err := os.OpenFile(fn, os.O_WRONLY, fileutil.PrivateFileMode)
+	require.NoError(t, err)
+	_, err = f.WriteAt(make([]byte, minSectorSize), (start/minSectorSize+2)*minSectorSize)
+	require.NoError(t, err)
+	f.Close()
+
+	w, err = Open(p, walpb.Snapshot{})
+	require.NoError(t, err)
+	_, err = w.ReadAll()
+	require.Equal(t, io.ErrUnexpectedEOF, errors.Cause(err))
+	w.Close(false)
+
+	//TESTCASE: the torn record is discarded, and the WAL is ready to append
+	records, discarded, err = Repair(p)
+	require.NoError(t, err)
+	require.Equal(t, 1, records)
+	require.Equal(t, end-start, discarded)
+	w, err = Open(p, walpb.Snapshot{})
+	require.NoError(t, err)
+	ents, err := w.ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, 9, len(ents))
+	err = w.SaveEntry(&walpb.Entry{Index: uint64(10)})
+	require.NoError(t, err)
+	w.Close(false)
+}
+
+func TestCompression(t *testing.T) {
+	p, err := ioutil.TempDir(os.TempDir(), "waltest")
+	require.NoError(t, err)
+	defer os.RemoveAll(p)
+
+	data := bytes.Repeat([]byte("compressible "), 1000)
+	w, err := Create(p)
+	require.NoError(t, err)
+	// the first segment is plain, the second one is compressed
+	for i := 1; i <= 10; i++ {
+		if i == 6 {
+			w.SetCompression(true)
+			err = w.cut()
+			require.NoError(t, err)
+		}
+		err = w.SaveEntry(&walpb.Entry{Index: uint64(i), Data: data})
+		require.NoError(t, err)
+	}
+	names := append([]string{}, w.walNames...)
+	w.Close(false)
+	require.Equal(t, 2, len(names))
+
+	//TESTCASE: entries of the compressed segment are not stored as is
+	plain, err := ioutil.ReadFile(filepath.Join(p, filepath.Base(names[0])))
+	require.NoError(t, err)
+	require.True(t, bytes.Contains(plain, data))
+	compressed, err := ioutil.ReadFile(filepath.Join(p, filepath.Base(names[1])))
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(compressed, data))
+
+	//TESTCASE: segments of both formats are readable, and the crc chain is intact
+	w, err = OpenAtBeginning(p)
+	require.NoError(t, err)
+	ents, err := w.ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, 10, len(ents))
+	for i, ent := range ents {
+		require.Equal(t, uint64(i+1), ent.Index)
+		require.Equal(t, data, ent.Data)
+	}
+	w.Close(false)
+}
+
+func TestCompressionOptions(t *testing.T) {
+	p, err := ioutil.TempDir(os.TempDir(), "waltest")
+	require.NoError(t, err)
+	defer os.RemoveAll(p)
+
+	data := bytes.Repeat([]byte("compressible "), 1000)
+	w, err := CreateExt(p, Options{Compress: true})
+	require.NoError(t, err)
+	err = w.SaveEntry(&walpb.Entry{Index: 1, Data: data})
+	require.NoError(t, err)
+	w.Close(false)
+
+	//TESTCASE: the first segment is compressed
+	names, err := readWalNames(p)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(names))
+	compressed, err := ioutil.ReadFile(filepath.Join(p, names[0]))
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(compressed, data))
+
+	//TESTCASE: the segment created when reopening is compressed as well
+	w, err = OpenAtBeginningExt(p, Options{Compress: true})
+	require.NoError(t, err)
+	ents, err := w.ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(ents))
+	require.Equal(t, data, ents[0].Data)
+	err = w.SaveEntry(&walpb.Entry{Index: 2, Data: data})
+	require.NoError(t, err)
+	w.Close(false)
+	names, err = readWalNames(p)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(names))
+	compressed, err = ioutil.ReadFile(filepath.Join(p, names[1]))
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(compressed, data))
+}
+
+func TestReadRecords(t *testing.T) {
+	p, err := ioutil.TempDir(os.TempDir(), "waltest")
+	require.NoError(t, err)
+	defer os.RemoveAll(p)
+
+	w, err := Create(p)
+	require.NoError(t, err)
+	for i := 1; i <= 6; i++ {
+		err = w.SaveEntry(&walpb.Entry{Index: uint64(i), Data: []byte{byte(i)}})
+		require.NoError(t, err)
+		if i == 3 {
+			err = w.cut()
+			require.NoError(t, err)
+		}
+	}
+	err = w.ReleaseTo(3)
+	require.NoError(t, err)
+	w.Close(false)
+
+	//TESTCASE: all records of the remaining segments are read, including the crc ones
+	first, err := FirstIndex(p)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), first)
+	w, err = OpenForRead(p, walpb.Snapshot{Index: first})
+	require.NoError(t, err)
+	var types []string
+	var indices []uint64
+	err = w.ReadRecords(func(rec *walpb.Record, ent *walpb.Entry) error {
+		types = append(types, RecordTypeName(rec.Type))
+		if ent != nil {
+			indices = append(indices, ent.Index)
+			require.Equal(t, []byte{byte(ent.Index)}, ent.Data)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"crc", "entry", "entry", "entry"}, types)
+	require.Equal(t, []uint64{4, 5, 6}, indices)
+	w.Close(false)
+}
@@ -16,8 +16,15 @@ package walpb
 
 import "errors"
 
+// EnvelopeVersion is the version of the payload encoding written by WrapPayload.
+const EnvelopeVersion = 1
+
+// envelopeVersionTag is the key of Envelope.Version, field 1 of wire type varint.
+const envelopeVersionTag = 0x08
+
 var (
-	ErrCRCMismatch = errors.New("walpb: crc mismatch")
+	ErrCRCMismatch    = errors.New("walpb: crc mismatch")
+	ErrUnknownVersion = errors.New("walpb: unknown envelope version")
 )
 
 func (rec *Record) Validate(crc uint32) error {
@@ -27,3 +34,32 @@ func (rec *Record) Validate(crc uint32) error {
 	rec.Reset()
 	return ErrCRCMismatch
 }
+
+// WrapPayload returns payload enveloped with EnvelopeVersion.
+func WrapPayload(payload []byte) (data []byte, err error) {
+	env := Envelope{Version: EnvelopeVersion, Payload: payload}
+	data, err = env.Marshal()
+	return
+}
+
+// UnwrapPayload returns the payload of an enveloped data.
+// Data written before the envelope was introduced (version 0) is returned as is. It's told apart
+// by the first byte, since an Envelope always begins with the tag of Version, while a legacy payload
+// is either a message beginning with a length-delimited field or an index name.
+// It fails with ErrUnknownVersion if the data is written by a newer or an unknown encoding.
+func UnwrapPayload(data []byte) (payload []byte, err error) {
+	if len(data) == 0 || data[0] != envelopeVersionTag {
+		payload = data
+		return
+	}
+	var env Envelope
+	if err = env.Unmarshal(data); err != nil {
+		return
+	}
+	if env.Version != EnvelopeVersion {
+		err = ErrUnknownVersion
+		return
+	}
+	payload = env.Payload
+	return
+}
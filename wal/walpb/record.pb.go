@@ -11,6 +11,8 @@
 		Entry
 		Record
 		Snapshot
+		Envelope
+		Batch
 */
 package walpb
 
@@ -32,20 +34,36 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+// Types of the entries written by indexer. The Data of an entry is an Envelope.
 type EntryType int32
 
 const (
-	EntryType_EntryNormal     EntryType = 0
-	EntryType_EntryConfChange EntryType = 1
+	EntryType_EntryInsert       EntryType = 0
+	EntryType_EntryDelete       EntryType = 1
+	EntryType_EntryAlterIndex   EntryType = 2
+	EntryType_EntryCreateIndex  EntryType = 3
+	EntryType_EntryDestroyIndex EntryType = 4
+	EntryType_EntryUpdate       EntryType = 5
+	EntryType_EntryBatch        EntryType = 6
 )
 
 var EntryType_name = map[int32]string{
-	0: "EntryNormal",
-	1: "EntryConfChange",
+	0: "EntryInsert",
+	1: "EntryDelete",
+	2: "EntryAlterIndex",
+	3: "EntryCreateIndex",
+	4: "EntryDestroyIndex",
+	5: "EntryUpdate",
+	6: "EntryBatch",
 }
 var EntryType_value = map[string]int32{
-	"EntryNormal":     0,
-	"EntryConfChange": 1,
+	"EntryInsert":       0,
+	"EntryDelete":       1,
+	"EntryAlterIndex":   2,
+	"EntryCreateIndex":  3,
+	"EntryDestroyIndex": 4,
+	"EntryUpdate":       5,
+	"EntryBatch":        6,
 }
 
 func (x EntryType) Enum() *EntryType {
@@ -102,10 +120,35 @@ func (m *Snapshot) String() string            { return proto.CompactTextString(m
 func (*Snapshot) ProtoMessage()               {}
 func (*Snapshot) Descriptor() ([]byte, []int) { return fileDescriptorRecord, []int{2} }
 
+// Envelope wraps the payload of an entry so that its encoding can evolve.
+type Envelope struct {
+	Version          uint32 `protobuf:"varint,1,opt,name=Version" json:"Version"`
+	Payload          []byte `protobuf:"bytes,2,opt,name=Payload" json:"Payload,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *Envelope) Reset()                    { *m = Envelope{} }
+func (m *Envelope) String() string            { return proto.CompactTextString(m) }
+func (*Envelope) ProtoMessage()               {}
+func (*Envelope) Descriptor() ([]byte, []int) { return fileDescriptorRecord, []int{3} }
+
+// Batch is the payload of an EntryBatch entry. Data of the entries inside isn't enveloped.
+type Batch struct {
+	Entries          []Entry `protobuf:"bytes,1,rep,name=Entries" json:"Entries"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Batch) Reset()                    { *m = Batch{} }
+func (m *Batch) String() string            { return proto.CompactTextString(m) }
+func (*Batch) ProtoMessage()               {}
+func (*Batch) Descriptor() ([]byte, []int) { return fileDescriptorRecord, []int{4} }
+
 func init() {
 	proto.RegisterType((*Entry)(nil), "walpb.Entry")
 	proto.RegisterType((*Record)(nil), "walpb.Record")
 	proto.RegisterType((*Snapshot)(nil), "walpb.Snapshot")
+	proto.RegisterType((*Envelope)(nil), "walpb.Envelope")
+	proto.RegisterType((*Batch)(nil), "walpb.Batch")
 	proto.RegisterEnum("walpb.EntryType", EntryType_name, EntryType_value)
 }
 func (m *Entry) Marshal() (dAtA []byte, err error) {
@@ -204,6 +247,69 @@ func (m *Snapshot) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *Envelope) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Envelope) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintRecord(dAtA, i, uint64(m.Version))
+	if m.Payload != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Payload)))
+		i += copy(dAtA[i:], m.Payload)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Batch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Batch) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, msg := range m.Entries {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRecord(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func encodeFixed64Record(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	dAtA[offset+1] = uint8(v >> 8)
@@ -273,6 +379,35 @@ func (m *Snapshot) Size() (n int) {
 	return n
 }
 
+func (m *Envelope) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovRecord(uint64(m.Version))
+	if m.Payload != nil {
+		l = len(m.Payload)
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Batch) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovRecord(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovRecord(x uint64) (n int) {
 	for {
 		n++
@@ -634,6 +769,189 @@ func (m *Snapshot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Envelope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Envelope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Envelope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Batch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Batch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Batch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, Entry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRecord(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -742,23 +1060,29 @@ var (
 func init() { proto.RegisterFile("record.proto", fileDescriptorRecord) }
 
 var fileDescriptorRecord = []byte{
-	// 279 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x8f, 0x41, 0x4e, 0x83, 0x40,
-	0x18, 0x85, 0x19, 0x19, 0x8c, 0xfe, 0x56, 0x4b, 0xc6, 0xc6, 0x90, 0x2e, 0x90, 0xb0, 0x22, 0x5d,
-	0x60, 0xf4, 0x04, 0xa6, 0xd5, 0x85, 0x9b, 0x2e, 0xb0, 0x17, 0x18, 0x61, 0xa4, 0x26, 0x85, 0x21,
-	0xe3, 0x24, 0xda, 0x85, 0xf7, 0xf0, 0x48, 0x2c, 0x3d, 0x81, 0x51, 0xbc, 0x88, 0xf9, 0x7f, 0x6c,
-	0xd3, 0xc4, 0xdd, 0xe3, 0x7b, 0xcc, 0x7b, 0xef, 0x87, 0x81, 0x51, 0xb9, 0x36, 0x45, 0xda, 0x18,
-	0x6d, 0xb5, 0xf0, 0x5e, 0xe4, 0xaa, 0x79, 0x18, 0x8f, 0x4a, 0x5d, 0x6a, 0x22, 0x17, 0xa8, 0x7a,
-	0x33, 0x7e, 0x03, 0xef, 0xb6, 0xb6, 0x66, 0x2d, 0x26, 0xc0, 0x17, 0xeb, 0x46, 0x05, 0x2c, 0x62,
-	0xc9, 0xc9, 0x95, 0x9f, 0xd2, 0xa3, 0x94, 0x3c, 0xe4, 0x53, 0xde, 0x7e, 0x9e, 0x3b, 0x19, 0xfd,
-	0x23, 0x02, 0xe0, 0x0b, 0x65, 0xaa, 0x60, 0x2f, 0x62, 0x09, 0xdf, 0x3a, 0xca, 0x54, 0x62, 0x0c,
-	0xde, 0x5d, 0x5d, 0xa8, 0xd7, 0xc0, 0xdd, 0xb1, 0x7a, 0x24, 0x04, 0xf0, 0x1b, 0x69, 0x65, 0xc0,
-	0x23, 0x96, 0x0c, 0x32, 0xd2, 0xf1, 0x1c, 0xf6, 0x33, 0xda, 0x8a, 0x99, 0x76, 0xd3, 0xef, 0x6e,
-	0x32, 0x91, 0x88, 0x33, 0x70, 0x73, 0x93, 0x53, 0xd9, 0xf1, 0x9f, 0x81, 0x00, 0xf3, 0x0a, 0xcc,
-	0x73, 0xfb, 0x3c, 0xd4, 0xf1, 0x35, 0x1c, 0xdc, 0xd7, 0xb2, 0x79, 0x5e, 0x6a, 0x8b, 0x5b, 0x9e,
-	0x68, 0x0b, 0xdb, 0xdd, 0x42, 0x88, 0xda, 0xfe, 0x5d, 0x80, 0x64, 0x72, 0x09, 0x87, 0xdb, 0xa3,
-	0xc5, 0x10, 0x8e, 0xe8, 0x63, 0xae, 0x4d, 0x25, 0x57, 0xbe, 0x23, 0x4e, 0x61, 0x48, 0x60, 0xa6,
-	0xeb, 0xc7, 0xd9, 0x52, 0xd6, 0xa5, 0xf2, 0xd9, 0x74, 0xd4, 0x7e, 0x87, 0x4e, 0xdb, 0x85, 0xec,
-	0xa3, 0x0b, 0xd9, 0x57, 0x17, 0xb2, 0xf7, 0x9f, 0xd0, 0xf9, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x27,
-	0x39, 0xe8, 0xce, 0x85, 0x01, 0x00, 0x00,
+	// 380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x65, 0x90, 0xcd, 0x4e, 0xc2, 0x40,
+	0x14, 0x85, 0x5b, 0xda, 0x02, 0x5e, 0x2a, 0xd4, 0x11, 0x4d, 0xc3, 0x02, 0x4d, 0x57, 0x84, 0x18,
+	0x4c, 0x48, 0xdc, 0x2b, 0xc2, 0x82, 0x8d, 0x31, 0xf5, 0x67, 0x5f, 0xdb, 0x09, 0x90, 0xd4, 0x4e,
+	0x33, 0x9d, 0xa8, 0x5d, 0xf8, 0x0e, 0x2e, 0x7d, 0x24, 0x96, 0x3e, 0x81, 0xf1, 0xe7, 0x45, 0x9c,
+	0xb9, 0xa5, 0x0d, 0x89, 0x8b, 0x49, 0xee, 0x7c, 0xe7, 0xce, 0xb9, 0x67, 0x2e, 0xd8, 0x9c, 0x86,
+	0x8c, 0x47, 0xa3, 0x94, 0x33, 0xc1, 0x88, 0xf5, 0x1c, 0xc4, 0xe9, 0x43, 0xaf, 0xbb, 0x60, 0x0b,
+	0x86, 0xe4, 0x54, 0x55, 0x85, 0xe8, 0xbd, 0x82, 0x35, 0x4b, 0x04, 0xcf, 0xc9, 0x10, 0xcc, 0xdb,
+	0x3c, 0xa5, 0xae, 0x7e, 0xac, 0x0f, 0xda, 0x63, 0x67, 0x84, 0x8f, 0x46, 0xa8, 0x29, 0x3e, 0x31,
+	0xd7, 0x9f, 0x47, 0x9a, 0x8f, 0x3d, 0xc4, 0x95, 0xbd, 0x94, 0x3f, 0xba, 0x35, 0xd9, 0x6b, 0x56,
+	0x8a, 0x24, 0xa4, 0x07, 0xd6, 0x3c, 0x89, 0xe8, 0x8b, 0x6b, 0x6c, 0x49, 0x05, 0x22, 0x04, 0xcc,
+	0x69, 0x20, 0x02, 0xd7, 0x94, 0x92, 0xed, 0x63, 0xed, 0x5d, 0x41, 0xdd, 0xc7, 0xac, 0xca, 0x53,
+	0x94, 0xf3, 0x8d, 0xd2, 0x53, 0x11, 0x72, 0x08, 0x46, 0xc8, 0x43, 0x1c, 0xb6, 0xbb, 0x11, 0x14,
+	0x50, 0x7e, 0x91, 0xf2, 0x33, 0x0a, 0x3f, 0x55, 0x7b, 0xe7, 0xd0, 0xbc, 0x49, 0x82, 0x34, 0x5b,
+	0x32, 0xa1, 0xb2, 0xac, 0x30, 0x8b, 0xbe, 0x9d, 0x05, 0x11, 0x4e, 0xfb, 0xf7, 0x03, 0x45, 0xbc,
+	0x29, 0x34, 0x67, 0xc9, 0x13, 0x8d, 0x99, 0x9c, 0xdc, 0x87, 0xc6, 0x3d, 0xe5, 0xd9, 0x8a, 0x25,
+	0xe8, 0x51, 0x4e, 0x2f, 0xa1, 0x74, 0x69, 0x5c, 0x07, 0x79, 0xcc, 0x82, 0x08, 0x8d, 0x6c, 0xbf,
+	0xbc, 0x7a, 0x67, 0x60, 0x4d, 0x02, 0x11, 0x2e, 0xc9, 0x09, 0x34, 0xd4, 0x0e, 0x57, 0x34, 0x93,
+	0x16, 0xc6, 0xa0, 0x35, 0xb6, 0xb7, 0x37, 0x5b, 0x1a, 0x6e, 0x5a, 0x86, 0x6f, 0x3a, 0xec, 0x54,
+	0x2b, 0x27, 0x1d, 0x68, 0xe1, 0x65, 0x9e, 0x64, 0x94, 0x0b, 0x47, 0xab, 0xc0, 0x94, 0xc6, 0x54,
+	0x50, 0x47, 0x27, 0xfb, 0xd0, 0x41, 0x70, 0x11, 0xcb, 0xec, 0xb8, 0x65, 0xa7, 0x46, 0xba, 0xe0,
+	0x20, 0xbc, 0xe4, 0x34, 0x10, 0xb4, 0xa0, 0x06, 0x39, 0x80, 0xbd, 0xcd, 0xdb, 0x4c, 0x70, 0x96,
+	0x17, 0xd8, 0xac, 0x2c, 0xef, 0x52, 0xb9, 0x3f, 0xea, 0x58, 0xa4, 0x0d, 0x50, 0x44, 0x53, 0xf1,
+	0x9d, 0xfa, 0xa4, 0xbb, 0xfe, 0xee, 0x6b, 0xeb, 0x9f, 0xbe, 0xfe, 0x21, 0xcf, 0x97, 0x3c, 0xef,
+	0xbf, 0x7d, 0xed, 0x0f, 0x84, 0xb9, 0x9c, 0x0e, 0x62, 0x02, 0x00, 0x00,
 }